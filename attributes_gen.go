@@ -0,0 +1,673 @@
+// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package html
+
+// AbbrAttr returns an abbr attribute, used on th elements.
+func AbbrAttr(v string) Node {
+	return Attr("abbr", v)
+}
+
+// Accept returns an accept attribute, used on input elements.
+func Accept(v string) Node {
+	return Attr("accept", v)
+}
+
+// AcceptCharset returns an accept-charset attribute, used on form elements.
+func AcceptCharset(v string) Node {
+	return Attr("accept-charset", v)
+}
+
+// AccessKey returns a global accesskey attribute.
+func AccessKey(v string) Node {
+	return Attr("accesskey", v)
+}
+
+// Action returns an action attribute, used on form elements.
+func Action(v string) Node {
+	return Attr("action", v)
+}
+
+// Allow returns an allow attribute, used on iframe elements.
+func Allow(v string) Node {
+	return Attr("allow", v)
+}
+
+// AllowFullscreen returns a boolean allowfullscreen attribute, used on iframe elements.
+func AllowFullscreen() Node {
+	return Attr("allowfullscreen")
+}
+
+// Alt returns an alt attribute, used on area, img, and input elements.
+func Alt(v string) Node {
+	return Attr("alt", v)
+}
+
+// As returns an as attribute, used on link elements.
+func As(v string) Node {
+	return Attr("as", v)
+}
+
+// Async returns a boolean async attribute, used on script elements.
+func Async() Node {
+	return Attr("async")
+}
+
+// AutoCapitalize returns a global autocapitalize attribute.
+func AutoCapitalize(v string) Node {
+	return Attr("autocapitalize", v)
+}
+
+// AutoComplete returns an autocomplete attribute, used on form, input, select, and textarea elements.
+func AutoComplete(v string) Node {
+	return Attr("autocomplete", v)
+}
+
+// AutoFocus returns a global boolean autofocus attribute.
+func AutoFocus() Node {
+	return Attr("autofocus")
+}
+
+// AutoPlay returns a boolean autoplay attribute, used on audio and video elements.
+func AutoPlay() Node {
+	return Attr("autoplay")
+}
+
+// Blocking returns a blocking attribute, used on link, script, and style elements.
+func Blocking(v string) Node {
+	return Attr("blocking", v)
+}
+
+// Charset returns a charset attribute, used on meta elements.
+func Charset(v string) Node {
+	return Attr("charset", v)
+}
+
+// Checked returns a boolean checked attribute, used on input elements.
+func Checked() Node {
+	return Attr("checked")
+}
+
+// CiteAttr returns a cite attribute, used on blockquote, del, ins, and q elements.
+func CiteAttr(v string) Node {
+	return Attr("cite", v)
+}
+
+// Class returns a global class attribute.
+func Class(v string) Node {
+	return Attr("class", v)
+}
+
+// Cols returns a cols attribute, used on textarea elements.
+func Cols(v string) Node {
+	return Attr("cols", v)
+}
+
+// ColSpan returns a colspan attribute, used on td and th elements.
+func ColSpan(v string) Node {
+	return Attr("colspan", v)
+}
+
+// Content returns a content attribute, used on meta elements.
+func Content(v string) Node {
+	return Attr("content", v)
+}
+
+// ContentEditable returns a global contenteditable attribute.
+func ContentEditable(v string) Node {
+	return Attr("contenteditable", v)
+}
+
+// Controls returns a boolean controls attribute, used on audio and video elements.
+func Controls() Node {
+	return Attr("controls")
+}
+
+// Coords returns a coords attribute, used on area elements.
+func Coords(v string) Node {
+	return Attr("coords", v)
+}
+
+// CrossOrigin returns a crossorigin attribute, used on audio, img, link, script, and video elements.
+func CrossOrigin(v string) Node {
+	return Attr("crossorigin", v)
+}
+
+// DateTime returns a datetime attribute, used on del, ins, and time elements.
+func DateTime(v string) Node {
+	return Attr("datetime", v)
+}
+
+// Decoding returns a decoding attribute, used on img elements.
+func Decoding(v string) Node {
+	return Attr("decoding", v)
+}
+
+// Default returns a boolean default attribute, used on track elements.
+func Default() Node {
+	return Attr("default")
+}
+
+// Defer returns a boolean defer attribute, used on script elements.
+func Defer() Node {
+	return Attr("defer")
+}
+
+// Dir returns a global dir attribute.
+func Dir(v string) Node {
+	return Attr("dir", v)
+}
+
+// DirName returns a dirname attribute, used on input and textarea elements.
+func DirName(v string) Node {
+	return Attr("dirname", v)
+}
+
+// Disabled returns a boolean disabled attribute, used on button, fieldset, input, link, optgroup, option, select, and textarea elements.
+func Disabled() Node {
+	return Attr("disabled")
+}
+
+// Download returns a download attribute, used on a and area elements.
+func Download(v string) Node {
+	return Attr("download", v)
+}
+
+// Draggable returns a global draggable attribute.
+func Draggable(v string) Node {
+	return Attr("draggable", v)
+}
+
+// EncType returns an enctype attribute, used on form elements.
+func EncType(v string) Node {
+	return Attr("enctype", v)
+}
+
+// EnterKeyHint returns a global enterkeyhint attribute.
+func EnterKeyHint(v string) Node {
+	return Attr("enterkeyhint", v)
+}
+
+// FetchPriority returns a fetchpriority attribute, used on img, link, and script elements.
+func FetchPriority(v string) Node {
+	return Attr("fetchpriority", v)
+}
+
+// For returns a for attribute, used on label and output elements.
+func For(v string) Node {
+	return Attr("for", v)
+}
+
+// FormAttr returns a form attribute, used on button, fieldset, input, object, output, select, and textarea elements.
+func FormAttr(v string) Node {
+	return Attr("form", v)
+}
+
+// FormAction returns a formaction attribute, used on button and input elements.
+func FormAction(v string) Node {
+	return Attr("formaction", v)
+}
+
+// FormEncType returns a formenctype attribute, used on button and input elements.
+func FormEncType(v string) Node {
+	return Attr("formenctype", v)
+}
+
+// FormMethod returns a formmethod attribute, used on button and input elements.
+func FormMethod(v string) Node {
+	return Attr("formmethod", v)
+}
+
+// FormNoValidate returns a boolean formnovalidate attribute, used on button and input elements.
+func FormNoValidate() Node {
+	return Attr("formnovalidate")
+}
+
+// FormTarget returns a formtarget attribute, used on button and input elements.
+func FormTarget(v string) Node {
+	return Attr("formtarget", v)
+}
+
+// Headers returns a headers attribute, used on td and th elements.
+func Headers(v string) Node {
+	return Attr("headers", v)
+}
+
+// Height returns a height attribute, used on canvas, embed, iframe, img, input, object, source, and video elements.
+func Height(v string) Node {
+	return Attr("height", v)
+}
+
+// Hidden returns a global boolean hidden attribute.
+func Hidden() Node {
+	return Attr("hidden")
+}
+
+// High returns a high attribute, used on meter elements.
+func High(v string) Node {
+	return Attr("high", v)
+}
+
+// Href returns a href attribute, used on a, area, base, and link elements.
+func Href(v string) Node {
+	return Attr("href", v)
+}
+
+// HrefLang returns a hreflang attribute, used on a and link elements.
+func HrefLang(v string) Node {
+	return Attr("hreflang", v)
+}
+
+// HTTPEquiv returns a http-equiv attribute, used on meta elements.
+func HTTPEquiv(v string) Node {
+	return Attr("http-equiv", v)
+}
+
+// ID returns a global id attribute.
+func ID(v string) Node {
+	return Attr("id", v)
+}
+
+// ImageSizes returns an imagesizes attribute, used on link elements.
+func ImageSizes(v string) Node {
+	return Attr("imagesizes", v)
+}
+
+// ImageSrcSet returns an imagesrcset attribute, used on link elements.
+func ImageSrcSet(v string) Node {
+	return Attr("imagesrcset", v)
+}
+
+// Inert returns a global boolean inert attribute.
+func Inert() Node {
+	return Attr("inert")
+}
+
+// InputMode returns a global inputmode attribute.
+func InputMode(v string) Node {
+	return Attr("inputmode", v)
+}
+
+// Integrity returns an integrity attribute, used on link and script elements.
+func Integrity(v string) Node {
+	return Attr("integrity", v)
+}
+
+// IsMap returns a boolean ismap attribute, used on img elements.
+func IsMap() Node {
+	return Attr("ismap")
+}
+
+// ItemID returns a global itemid attribute.
+func ItemID(v string) Node {
+	return Attr("itemid", v)
+}
+
+// ItemProp returns a global itemprop attribute.
+func ItemProp(v string) Node {
+	return Attr("itemprop", v)
+}
+
+// ItemRef returns a global itemref attribute.
+func ItemRef(v string) Node {
+	return Attr("itemref", v)
+}
+
+// ItemScope returns a global boolean itemscope attribute.
+func ItemScope() Node {
+	return Attr("itemscope")
+}
+
+// ItemType returns a global itemtype attribute.
+func ItemType(v string) Node {
+	return Attr("itemtype", v)
+}
+
+// Kind returns a kind attribute, used on track elements.
+func Kind(v string) Node {
+	return Attr("kind", v)
+}
+
+// LabelAttr returns a label attribute, used on optgroup, option, and track elements.
+func LabelAttr(v string) Node {
+	return Attr("label", v)
+}
+
+// Lang returns a global lang attribute.
+func Lang(v string) Node {
+	return Attr("lang", v)
+}
+
+// List returns a list attribute, used on input elements.
+func List(v string) Node {
+	return Attr("list", v)
+}
+
+// Loading returns a loading attribute, used on iframe and img elements.
+func Loading(v string) Node {
+	return Attr("loading", v)
+}
+
+// Loop returns a boolean loop attribute, used on audio and video elements.
+func Loop() Node {
+	return Attr("loop")
+}
+
+// Low returns a low attribute, used on meter elements.
+func Low(v string) Node {
+	return Attr("low", v)
+}
+
+// Max returns a max attribute, used on input, meter, and progress elements.
+func Max(v string) Node {
+	return Attr("max", v)
+}
+
+// MaxLength returns a maxlength attribute, used on input and textarea elements.
+func MaxLength(v string) Node {
+	return Attr("maxlength", v)
+}
+
+// Media returns a media attribute, used on link, meta, source, and style elements.
+func Media(v string) Node {
+	return Attr("media", v)
+}
+
+// Method returns a method attribute, used on form elements.
+func Method(v string) Node {
+	return Attr("method", v)
+}
+
+// Min returns a min attribute, used on input and meter elements.
+func Min(v string) Node {
+	return Attr("min", v)
+}
+
+// MinLength returns a minlength attribute, used on input and textarea elements.
+func MinLength(v string) Node {
+	return Attr("minlength", v)
+}
+
+// Multiple returns a boolean multiple attribute, used on input and select elements.
+func Multiple() Node {
+	return Attr("multiple")
+}
+
+// Muted returns a boolean muted attribute, used on audio and video elements.
+func Muted() Node {
+	return Attr("muted")
+}
+
+// Name returns a name attribute, used on button, details, fieldset, form, iframe, input, map, meta, object, output, select, slot, and textarea elements.
+func Name(v string) Node {
+	return Attr("name", v)
+}
+
+// NoModule returns a boolean nomodule attribute, used on script elements.
+func NoModule() Node {
+	return Attr("nomodule")
+}
+
+// Nonce returns a global nonce attribute.
+func Nonce(v string) Node {
+	return Attr("nonce", v)
+}
+
+// NoValidate returns a boolean novalidate attribute, used on form elements.
+func NoValidate() Node {
+	return Attr("novalidate")
+}
+
+// Open returns a boolean open attribute, used on details and dialog elements.
+func Open() Node {
+	return Attr("open")
+}
+
+// Optimum returns an optimum attribute, used on meter elements.
+func Optimum(v string) Node {
+	return Attr("optimum", v)
+}
+
+// Pattern returns a pattern attribute, used on input elements.
+func Pattern(v string) Node {
+	return Attr("pattern", v)
+}
+
+// Ping returns a ping attribute, used on a and area elements.
+func Ping(v string) Node {
+	return Attr("ping", v)
+}
+
+// Placeholder returns a placeholder attribute, used on input and textarea elements.
+func Placeholder(v string) Node {
+	return Attr("placeholder", v)
+}
+
+// PlaysInline returns a boolean playsinline attribute, used on video elements.
+func PlaysInline() Node {
+	return Attr("playsinline")
+}
+
+// Popover returns a global popover attribute.
+func Popover(v string) Node {
+	return Attr("popover", v)
+}
+
+// PopoverTarget returns a popovertarget attribute, used on button and input elements.
+func PopoverTarget(v string) Node {
+	return Attr("popovertarget", v)
+}
+
+// PopoverTargetAction returns a popovertargetaction attribute, used on button and input elements.
+func PopoverTargetAction(v string) Node {
+	return Attr("popovertargetaction", v)
+}
+
+// Poster returns a poster attribute, used on video elements.
+func Poster(v string) Node {
+	return Attr("poster", v)
+}
+
+// Preload returns a preload attribute, used on audio and video elements.
+func Preload(v string) Node {
+	return Attr("preload", v)
+}
+
+// ReadOnly returns a boolean readonly attribute, used on input and textarea elements.
+func ReadOnly() Node {
+	return Attr("readonly")
+}
+
+// ReferrerPolicy returns a referrerpolicy attribute, used on a, area, iframe, img, link, and script elements.
+func ReferrerPolicy(v string) Node {
+	return Attr("referrerpolicy", v)
+}
+
+// Rel returns a rel attribute, used on a, area, form, and link elements.
+func Rel(v string) Node {
+	return Attr("rel", v)
+}
+
+// Required returns a boolean required attribute, used on input, select, and textarea elements.
+func Required() Node {
+	return Attr("required")
+}
+
+// Reversed returns a boolean reversed attribute, used on ol elements.
+func Reversed() Node {
+	return Attr("reversed")
+}
+
+// Role returns a global role attribute.
+func Role(v string) Node {
+	return Attr("role", v)
+}
+
+// Rows returns a rows attribute, used on textarea elements.
+func Rows(v string) Node {
+	return Attr("rows", v)
+}
+
+// RowSpan returns a rowspan attribute, used on td and th elements.
+func RowSpan(v string) Node {
+	return Attr("rowspan", v)
+}
+
+// Sandbox returns a sandbox attribute, used on iframe elements.
+func Sandbox(v string) Node {
+	return Attr("sandbox", v)
+}
+
+// Scope returns a scope attribute, used on th elements.
+func Scope(v string) Node {
+	return Attr("scope", v)
+}
+
+// Selected returns a boolean selected attribute, used on option elements.
+func Selected() Node {
+	return Attr("selected")
+}
+
+// ShadowRootMode returns a shadowrootmode attribute, used on template elements.
+func ShadowRootMode(v string) Node {
+	return Attr("shadowrootmode", v)
+}
+
+// Shape returns a shape attribute, used on area elements.
+func Shape(v string) Node {
+	return Attr("shape", v)
+}
+
+// Size returns a size attribute, used on input and select elements.
+func Size(v string) Node {
+	return Attr("size", v)
+}
+
+// Sizes returns a sizes attribute, used on img, link, and source elements.
+func Sizes(v string) Node {
+	return Attr("sizes", v)
+}
+
+// SlotAttr returns a global slot attribute.
+func SlotAttr(v string) Node {
+	return Attr("slot", v)
+}
+
+// SpanAttr returns a span attribute, used on col and colgroup elements.
+func SpanAttr(v string) Node {
+	return Attr("span", v)
+}
+
+// SpellCheck returns a global spellcheck attribute.
+func SpellCheck(v string) Node {
+	return Attr("spellcheck", v)
+}
+
+// Src returns a src attribute, used on audio, embed, iframe, img, input, script, source, track, and video elements.
+func Src(v string) Node {
+	return Attr("src", v)
+}
+
+// SrcDoc returns a srcdoc attribute, used on iframe elements.
+func SrcDoc(v string) Node {
+	return Attr("srcdoc", v)
+}
+
+// SrcLang returns a srclang attribute, used on track elements.
+func SrcLang(v string) Node {
+	return Attr("srclang", v)
+}
+
+// SrcSet returns a srcset attribute, used on img and source elements.
+func SrcSet(v string) Node {
+	return Attr("srcset", v)
+}
+
+// Start returns a start attribute, used on ol elements.
+func Start(v string) Node {
+	return Attr("start", v)
+}
+
+// Step returns a step attribute, used on input elements.
+func Step(v string) Node {
+	return Attr("step", v)
+}
+
+// StyleAttr returns a global style attribute.
+func StyleAttr(v string) Node {
+	return Attr("style", v)
+}
+
+// TabIndex returns a global tabindex attribute.
+func TabIndex(v string) Node {
+	return Attr("tabindex", v)
+}
+
+// Target returns a target attribute, used on a, area, base, and form elements.
+func Target(v string) Node {
+	return Attr("target", v)
+}
+
+// TitleAttr returns a global title attribute.
+func TitleAttr(v string) Node {
+	return Attr("title", v)
+}
+
+// Translate returns a global translate attribute.
+func Translate(v string) Node {
+	return Attr("translate", v)
+}
+
+// Type returns a type attribute, used on a, button, embed, input, link, object, ol, script, and source elements.
+func Type(v string) Node {
+	return Attr("type", v)
+}
+
+// UseMap returns a usemap attribute, used on img elements.
+func UseMap(v string) Node {
+	return Attr("usemap", v)
+}
+
+// Value returns a value attribute, used on button, data, input, li, meter, option, and progress elements.
+func Value(v string) Node {
+	return Attr("value", v)
+}
+
+// Width returns a width attribute, used on canvas, embed, iframe, img, input, object, source, and video elements.
+func Width(v string) Node {
+	return Attr("width", v)
+}
+
+// Wrap returns a wrap attribute, used on textarea elements.
+func Wrap(v string) Node {
+	return Attr("wrap", v)
+}
+
+// booleanAttributes are rendered by name only, if their value is empty or the attribute name.
+// See https://html.spec.whatwg.org/multipage/indices.html#attributes-3
+var booleanAttributes = map[string]struct{}{
+	"allowfullscreen": {},
+	"async":           {},
+	"autofocus":       {},
+	"autoplay":        {},
+	"checked":         {},
+	"controls":        {},
+	"default":         {},
+	"defer":           {},
+	"disabled":        {},
+	"formnovalidate":  {},
+	"hidden":          {},
+	"inert":           {},
+	"ismap":           {},
+	"itemscope":       {},
+	"loop":            {},
+	"multiple":        {},
+	"muted":           {},
+	"nomodule":        {},
+	"novalidate":      {},
+	"open":            {},
+	"playsinline":     {},
+	"readonly":        {},
+	"required":        {},
+	"reversed":        {},
+	"selected":        {},
+}
@@ -0,0 +1,160 @@
+// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package html
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBooleanAttributes(t *testing.T) {
+	cases := map[string]func() Node{
+		"allowfullscreen": AllowFullscreen,
+		"async":           Async,
+		"autofocus":       AutoFocus,
+		"autoplay":        AutoPlay,
+		"checked":         Checked,
+		"controls":        Controls,
+		"default":         Default,
+		"defer":           Defer,
+		"disabled":        Disabled,
+		"formnovalidate":  FormNoValidate,
+		"hidden":          Hidden,
+		"inert":           Inert,
+		"ismap":           IsMap,
+		"itemscope":       ItemScope,
+		"loop":            Loop,
+		"multiple":        Multiple,
+		"muted":           Muted,
+		"nomodule":        NoModule,
+		"novalidate":      NoValidate,
+		"open":            Open,
+		"playsinline":     PlaysInline,
+		"readonly":        ReadOnly,
+		"required":        Required,
+		"reversed":        Reversed,
+		"selected":        Selected,
+	}
+
+	for name, fn := range cases {
+		t.Run(fmt.Sprintf("should output %v", name), func(t *testing.T) {
+			n := El("div", fn())
+			Equal(t, fmt.Sprintf(`<div %v></div>`, name), n)
+		})
+	}
+}
+
+func TestSimpleAttributes(t *testing.T) {
+	cases := map[string]func(string) Node{
+		"abbr":                AbbrAttr,
+		"accept":              Accept,
+		"accept-charset":      AcceptCharset,
+		"accesskey":           AccessKey,
+		"action":              Action,
+		"allow":               Allow,
+		"alt":                 Alt,
+		"as":                  As,
+		"autocapitalize":      AutoCapitalize,
+		"autocomplete":        AutoComplete,
+		"blocking":            Blocking,
+		"charset":             Charset,
+		"cite":                CiteAttr,
+		"class":               Class,
+		"cols":                Cols,
+		"colspan":             ColSpan,
+		"content":             Content,
+		"contenteditable":     ContentEditable,
+		"coords":              Coords,
+		"crossorigin":         CrossOrigin,
+		"datetime":            DateTime,
+		"decoding":            Decoding,
+		"dir":                 Dir,
+		"dirname":             DirName,
+		"download":            Download,
+		"draggable":           Draggable,
+		"enctype":             EncType,
+		"enterkeyhint":        EnterKeyHint,
+		"fetchpriority":       FetchPriority,
+		"for":                 For,
+		"form":                FormAttr,
+		"formaction":          FormAction,
+		"formenctype":         FormEncType,
+		"formmethod":          FormMethod,
+		"formtarget":          FormTarget,
+		"headers":             Headers,
+		"height":              Height,
+		"high":                High,
+		"href":                Href,
+		"hreflang":            HrefLang,
+		"http-equiv":          HTTPEquiv,
+		"id":                  ID,
+		"imagesizes":          ImageSizes,
+		"imagesrcset":         ImageSrcSet,
+		"inputmode":           InputMode,
+		"integrity":           Integrity,
+		"itemid":              ItemID,
+		"itemprop":            ItemProp,
+		"itemref":             ItemRef,
+		"itemtype":            ItemType,
+		"kind":                Kind,
+		"label":               LabelAttr,
+		"lang":                Lang,
+		"list":                List,
+		"loading":             Loading,
+		"low":                 Low,
+		"max":                 Max,
+		"maxlength":           MaxLength,
+		"media":               Media,
+		"method":              Method,
+		"min":                 Min,
+		"minlength":           MinLength,
+		"name":                Name,
+		"nonce":               Nonce,
+		"optimum":             Optimum,
+		"pattern":             Pattern,
+		"ping":                Ping,
+		"placeholder":         Placeholder,
+		"popover":             Popover,
+		"popovertarget":       PopoverTarget,
+		"popovertargetaction": PopoverTargetAction,
+		"poster":              Poster,
+		"preload":             Preload,
+		"referrerpolicy":      ReferrerPolicy,
+		"rel":                 Rel,
+		"role":                Role,
+		"rows":                Rows,
+		"rowspan":             RowSpan,
+		"sandbox":             Sandbox,
+		"scope":               Scope,
+		"shadowrootmode":      ShadowRootMode,
+		"shape":               Shape,
+		"size":                Size,
+		"sizes":               Sizes,
+		"slot":                SlotAttr,
+		"span":                SpanAttr,
+		"spellcheck":          SpellCheck,
+		"src":                 Src,
+		"srcdoc":              SrcDoc,
+		"srclang":             SrcLang,
+		"srcset":              SrcSet,
+		"start":               Start,
+		"step":                Step,
+		"style":               StyleAttr,
+		"tabindex":            TabIndex,
+		"target":              Target,
+		"title":               TitleAttr,
+		"translate":           Translate,
+		"type":                Type,
+		"usemap":              UseMap,
+		"value":               Value,
+		"width":               Width,
+		"wrap":                Wrap,
+	}
+
+	for name, fn := range cases {
+		t.Run(fmt.Sprintf(`should output %v="hat"`, name), func(t *testing.T) {
+			n := El("div", fn("hat"))
+			Equal(t, fmt.Sprintf(`<div %v="hat"></div>`, name), n)
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package html
 
 import (
-	"fmt"
 	"os"
 	"testing"
 )
@@ -35,82 +34,6 @@ func ExampleClasses() {
 	// Output: <div class="party-hat"></div>
 }
 
-func TestBooleanAttributes(t *testing.T) {
-	cases := map[string]func() Node{
-		"async":       Async,
-		"autofocus":   AutoFocus,
-		"autoplay":    AutoPlay,
-		"controls":    Controls,
-		"defer":       Defer,
-		"disabled":    Disabled,
-		"loop":        Loop,
-		"multiple":    Multiple,
-		"muted":       Muted,
-		"playsinline": PlaysInline,
-		"readonly":    ReadOnly,
-		"required":    Required,
-		"selected":    Selected,
-	}
-
-	for name, fn := range cases {
-		t.Run(fmt.Sprintf("should output %v", name), func(t *testing.T) {
-			n := El("div", fn())
-			Equal(t, fmt.Sprintf(`<div %v></div>`, name), n)
-		})
-	}
-}
-
-func TestSimpleAttributes(t *testing.T) {
-	cases := map[string]func(string) Node{
-		"accept":       Accept,
-		"action":       Action,
-		"alt":          Alt,
-		"as":           As,
-		"autocomplete": AutoComplete,
-		"charset":      Charset,
-		"class":        Class,
-		"cols":         Cols,
-		"content":      Content,
-		"enctype":      EncType,
-		"for":          For,
-		"form":         FormAttr,
-		"height":       Height,
-		"href":         Href,
-		"id":           ID,
-		"lang":         Lang,
-		"loading":      Loading,
-		"max":          Max,
-		"maxlength":    MaxLength,
-		"method":       Method,
-		"min":          Min,
-		"minlength":    MinLength,
-		"name":         Name,
-		"pattern":      Pattern,
-		"placeholder":  Placeholder,
-		"poster":       Poster,
-		"preload":      Preload,
-		"rel":          Rel,
-		"role":         Role,
-		"rows":         Rows,
-		"src":          Src,
-		"srcset":       SrcSet,
-		"style":        StyleAttr,
-		"tabindex":     TabIndex,
-		"target":       Target,
-		"title":        TitleAttr,
-		"type":         Type,
-		"value":        Value,
-		"width":        Width,
-	}
-
-	for name, fn := range cases {
-		t.Run(fmt.Sprintf(`should output %v="hat"`, name), func(t *testing.T) {
-			n := El("div", fn("hat"))
-			Equal(t, fmt.Sprintf(`<div %v="hat"></div>`, name), n)
-		})
-	}
-}
-
 func TestAria(t *testing.T) {
 	t.Run("returns an attribute which name is prefixed with aria-", func(t *testing.T) {
 		n := Aria("selected", "true")
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"io"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/melias122/html"
+)
+
+// helpers by element or attribute name, found by calling the helper functions.
+var (
+	elements          = map[string]string{}
+	booleanAttributes = map[string]string{}
+	attributes        = map[string]string{}
+)
+
+func init() {
+	for _, fn := range elementHelpers {
+		elements[fn().(*html.Element).Name] = funcName(fn)
+	}
+	for _, fn := range booleanAttributeHelpers {
+		booleanAttributes[fn().(*html.Attribute).Name] = funcName(fn)
+	}
+	for _, fn := range attributeHelpers {
+		attributes[fn("").(*html.Attribute).Name] = funcName(fn)
+	}
+}
+
+// funcName returns the unqualified name of a function.
+func funcName(fn interface{}) string {
+	name := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name()
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// options for converting HTML to Go.
+type options struct {
+	// packageName of the generated file.
+	packageName string
+	// funcName of the generated function returning the Node.
+	funcName string
+	// qualified uses a regular import instead of a dot-import.
+	qualified bool
+}
+
+// convert the HTML read from r to gofmt-ed Go source.
+func convert(r io.Reader, opts options) ([]byte, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []html.Node
+	if isDocument(string(b)) {
+		n, err := html.Parse(strings.NewReader(string(b)))
+		if err != nil {
+			return nil, err
+		}
+		nodes = []html.Node{n}
+	} else {
+		if nodes, err = html.ParseFragment(strings.NewReader(string(b))); err != nil {
+			return nil, err
+		}
+	}
+
+	c := converter{opts: opts}
+	nodes = c.children(nodes, false)
+
+	var src strings.Builder
+	fmt.Fprintf(&src, "package %v\n\n", opts.packageName)
+	if opts.qualified {
+		src.WriteString("import \"github.com/melias122/html\"\n\n")
+	} else {
+		src.WriteString("import . \"github.com/melias122/html\"\n\n")
+	}
+	fmt.Fprintf(&src, "func %v() %v {\n\treturn ", opts.funcName, c.ident("Node"))
+	switch len(nodes) {
+	case 0:
+		src.WriteString("nil")
+	case 1:
+		c.node(&src, nodes[0])
+	default:
+		src.WriteString(c.ident("Group") + "([]" + c.ident("Node") + "{\n")
+		for _, n := range nodes {
+			c.node(&src, n)
+			src.WriteString(",\n")
+		}
+		src.WriteString("})")
+	}
+	src.WriteString("\n}\n")
+
+	return format.Source([]byte(src.String()))
+}
+
+// isDocument returns whether s starts with a doctype or html element, and should be parsed as a whole document.
+func isDocument(s string) bool {
+	s = strings.ToLower(strings.TrimSpace(s))
+	return strings.HasPrefix(s, "<!doctype") || strings.HasPrefix(s, "<html")
+}
+
+type converter struct {
+	opts options
+	// tmpl converts template actions, if converting a template.
+	tmpl *templateConverter
+}
+
+// ident qualifies an identifier from the html package if needed.
+func (c *converter) ident(name string) string {
+	if c.opts.qualified {
+		return "html." + name
+	}
+	return name
+}
+
+// children with whitespace in text collapsed, as it's mostly from indenting the HTML,
+// and whitespace-only text left out, except a space between template actions.
+// Whitespace is kept as is if preformatted is true.
+func (c *converter) children(nodes []html.Node, preformatted bool) []html.Node {
+	if preformatted {
+		return nodes
+	}
+
+	var result []html.Node
+	for i, n := range nodes {
+		t, ok := n.(html.TextNode)
+		if !ok {
+			result = append(result, n)
+			continue
+		}
+
+		text := strings.Join(strings.Fields(string(t)), " ")
+		if text == "" {
+			if i > 0 && i < len(nodes)-1 && isAction(nodes[i-1]) && isAction(nodes[i+1]) && !strings.Contains(string(t), "\n") {
+				result = append(result, html.Text(" "))
+			}
+			continue
+		}
+		if i > 0 && startsWithSpace(string(t)) {
+			text = " " + text
+		}
+		if i < len(nodes)-1 && endsWithSpace(string(t)) {
+			text += " "
+		}
+		result = append(result, html.Text(text))
+	}
+	return result
+}
+
+func isAction(n html.Node) bool {
+	_, ok := n.(action)
+	return ok
+}
+
+func (c *converter) node(w *strings.Builder, n html.Node) {
+	switch n := n.(type) {
+	case *html.Element:
+		c.element(w, n)
+	case html.DoctypeNode:
+		w.WriteString(c.ident("Doctype") + "(")
+		if n.Sibling != nil {
+			c.node(w, n.Sibling)
+		} else {
+			w.WriteString("nil")
+		}
+		w.WriteString(")")
+	case html.TextNode:
+		w.WriteString(c.ident("Text") + "(" + quote(string(n)) + ")")
+	case html.RawNode:
+		w.WriteString(c.ident("Raw") + "(" + quote(string(n)) + ")")
+	case untranslated:
+		w.WriteString(c.ident("Raw") + "(" + quote(string(n)) + ") /* TODO: translate */")
+	}
+}
+
+// expressions for the Nodes, which are more than one per Node for some template actions.
+func (c *converter) expressions(nodes []html.Node) []string {
+	var exprs []string
+	for _, n := range nodes {
+		if a, ok := n.(action); ok {
+			exprs = append(exprs, c.tmpl.expressions(a)...)
+			continue
+		}
+		var b strings.Builder
+		c.node(&b, n)
+		exprs = append(exprs, b.String())
+	}
+	return exprs
+}
+
+func (c *converter) element(w *strings.Builder, e *html.Element) {
+	var args []string
+	if fn, ok := elements[e.Name]; ok {
+		w.WriteString(c.ident(fn) + "(")
+	} else {
+		w.WriteString(c.ident("El") + "(")
+		args = append(args, strconv.Quote(e.Name))
+	}
+
+	for _, a := range e.Attributes() {
+		var b strings.Builder
+		c.attribute(&b, a.(*html.Attribute))
+		args = append(args, b.String())
+	}
+	w.WriteString(strings.Join(args, ", "))
+
+	children := e.ChildNodes()
+	if c.tmpl != nil {
+		children = c.tmpl.split(children)
+	}
+	children = c.children(children, e.Name == "pre" || e.Name == "textarea")
+
+	// Elements without child elements, template actions, or untranslated text are kept on one line, others have a line per child.
+	multiline := false
+	for _, child := range children {
+		switch child.(type) {
+		case *html.Element, action, untranslated:
+			multiline = true
+		}
+	}
+
+	for i, expr := range c.expressions(children) {
+		switch {
+		case multiline:
+			if i == 0 && len(args) > 0 {
+				w.WriteString(",")
+			}
+			w.WriteString("\n" + expr + ",")
+		case i > 0 || len(args) > 0:
+			w.WriteString(", " + expr)
+		default:
+			w.WriteString(expr)
+		}
+	}
+	if multiline {
+		w.WriteString("\n")
+	}
+	w.WriteString(")")
+}
+
+func (c *converter) attribute(w *strings.Builder, a *html.Attribute) {
+	value := quote(a.Value)
+	dynamic := false
+	if c.tmpl != nil {
+		if expr, ok := c.tmpl.attribute(a); ok {
+			w.WriteString(expr)
+			return
+		}
+		value, dynamic = c.tmpl.value(a.Value)
+	}
+
+	switch {
+	case strings.HasPrefix(a.Name, "data-") && len(a.Name) > len("data-"):
+		w.WriteString(c.ident("DataAttr") + "(" + strconv.Quote(strings.TrimPrefix(a.Name, "data-")) + ", " + value + ")")
+	case strings.HasPrefix(a.Name, "aria-") && len(a.Name) > len("aria-"):
+		w.WriteString(c.ident("Aria") + "(" + strconv.Quote(strings.TrimPrefix(a.Name, "aria-")) + ", " + value + ")")
+	case !dynamic && booleanAttributes[a.Name] != "" && (a.Boolean || a.Value == "" || strings.EqualFold(a.Value, a.Name)):
+		w.WriteString(c.ident(booleanAttributes[a.Name]) + "()")
+	case attributes[a.Name] != "" && !a.Boolean:
+		w.WriteString(c.ident(attributes[a.Name]) + "(" + value + ")")
+	case a.Boolean:
+		w.WriteString(c.ident("Attr") + "(" + strconv.Quote(a.Name) + ")")
+	default:
+		w.WriteString(c.ident("Attr") + "(" + strconv.Quote(a.Name) + ", " + value + ")")
+	}
+}
+
+// quote a string as a Go string literal, using a raw string literal for multiple lines or double quotes if possible.
+func quote(s string) string {
+	if strings.ContainsAny(s, "\n\"") && !strings.ContainsAny(s, "`\r") && strconv.CanBackquote(strings.ReplaceAll(s, "\n", "")) {
+		return "`" + s + "`"
+	}
+	return strconv.Quote(s)
+}
+
+func startsWithSpace(s string) bool {
+	return s != "" && strings.TrimLeft(s[:1], " \t\n\f\r") == ""
+}
+
+func endsWithSpace(s string) bool {
+	return s != "" && strings.TrimLeft(s[len(s)-1:], " \t\n\f\r") == ""
+}
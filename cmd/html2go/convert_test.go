@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	t.Run("uses helpers and falls back to El and Attr", func(t *testing.T) {
+		input := `<nav class="flex md:hidden" data-id="1" aria-label="Menu">
+  <a href="/" title="Home" target=_blank>Home &amp; away</a>
+  <button type="button" disabled>Go</button>
+  <my-el foo="bar" x-cloak></my-el>
+  <p>Some <em>em</em> text.</p>
+</nav>`
+		expected := `package components
+
+import . "github.com/melias122/html"
+
+func Component() Node {
+	return Nav(Class("flex md:hidden"), DataAttr("id", "1"), Aria("label", "Menu"),
+		A(Href("/"), TitleAttr("Home"), Target("_blank"), Text("Home & away")),
+		Button(Type("button"), Disabled(), Text("Go")),
+		El("my-el", Attr("foo", "bar"), Attr("x-cloak")),
+		P(
+			Text("Some "),
+			Em(Text("em")),
+			Text(" text."),
+		),
+	)
+}
+`
+		convertEqual(t, expected, input, options{packageName: "components", funcName: "Component"})
+	})
+
+	t.Run("keeps whitespace in pre and uses raw strings for multiple lines", func(t *testing.T) {
+		input := "<pre>  a\n b</pre><script>if (a) {\n  x(\"y\")\n}</script>"
+		expected := "package components\n\nimport . \"github.com/melias122/html\"\n\n" +
+			"func Component() Node {\n\treturn Group([]Node{\n\t\tPre(Text(`  a\n b`)),\n\t\tScript(Raw(`if (a) {\n  x(\"y\")\n}`)),\n\t})\n}\n"
+		convertEqual(t, expected, input, options{packageName: "components", funcName: "Component"})
+	})
+
+	t.Run("converts documents with qualified identifiers", func(t *testing.T) {
+		input := `<!doctype html><html lang="en"><head><title>Hat</title><style>p { color: red }</style></head><body><form><input required></form></body></html>`
+		expected := `package pages
+
+import "github.com/melias122/html"
+
+func Page() html.Node {
+	return html.Doctype(html.HTML(html.Lang("en"),
+		html.Head(
+			html.TitleEl(html.Text("Hat")),
+			html.StyleEl(html.Raw("p { color: red }")),
+		),
+		html.Body(
+			html.FormEl(
+				html.Input(html.Required()),
+			),
+		),
+	))
+}
+`
+		convertEqual(t, expected, input, options{packageName: "pages", funcName: "Page", qualified: true})
+	})
+}
+
+func convertEqual(t *testing.T, expected, input string, opts options) {
+	t.Helper()
+
+	src, err := convert(strings.NewReader(input), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(src) != expected {
+		t.Fatalf("expected\n%v\nbut got\n%v", expected, string(src))
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Run("reads stdin and writes stdout", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := run([]string{"-func", "Hat", "-package", "hats"}, strings.NewReader("<br>"), &out, io.Discard); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out.String(), "package hats") || !strings.Contains(out.String(), "func Hat() Node {\n\treturn Br()\n}") {
+			t.Fatal(out.String())
+		}
+	})
+
+	t.Run("returns an error for a missing file", func(t *testing.T) {
+		if err := run([]string{"does-not-exist.html"}, nil, &bytes.Buffer{}, io.Discard); err == nil {
+			t.FailNow()
+		}
+	})
+}
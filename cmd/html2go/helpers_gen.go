@@ -0,0 +1,259 @@
+// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package main
+
+import "github.com/melias122/html"
+
+// elementHelpers create elements, and are used for elements with the same name.
+var elementHelpers = []func(...html.Node) html.Node{
+	html.A,
+	html.Abbr,
+	html.Address,
+	html.Area,
+	html.Article,
+	html.Aside,
+	html.Audio,
+	html.B,
+	html.Base,
+	html.Bdi,
+	html.Bdo,
+	html.BlockQuote,
+	html.Body,
+	html.Br,
+	html.Button,
+	html.Canvas,
+	html.Caption,
+	html.Cite,
+	html.Code,
+	html.Col,
+	html.ColGroup,
+	html.DataEl,
+	html.DataList,
+	html.Dd,
+	html.Del,
+	html.Details,
+	html.Dfn,
+	html.Dialog,
+	html.Div,
+	html.Dl,
+	html.Dt,
+	html.Em,
+	html.Embed,
+	html.FieldSet,
+	html.FigCaption,
+	html.Figure,
+	html.Footer,
+	html.FormEl,
+	html.H1,
+	html.H2,
+	html.H3,
+	html.H4,
+	html.H5,
+	html.H6,
+	html.Head,
+	html.Header,
+	html.HGroup,
+	html.Hr,
+	html.HTML,
+	html.I,
+	html.IFrame,
+	html.Img,
+	html.Input,
+	html.Ins,
+	html.Kbd,
+	html.Label,
+	html.Legend,
+	html.Li,
+	html.Link,
+	html.Main,
+	html.MapEl,
+	html.Mark,
+	html.Menu,
+	html.Meta,
+	html.Meter,
+	html.Nav,
+	html.NoScript,
+	html.Object,
+	html.Ol,
+	html.OptGroup,
+	html.Option,
+	html.Output,
+	html.P,
+	html.Param,
+	html.Picture,
+	html.Pre,
+	html.Progress,
+	html.Q,
+	html.Rp,
+	html.Rt,
+	html.Ruby,
+	html.S,
+	html.Samp,
+	html.Script,
+	html.Search,
+	html.Section,
+	html.Select,
+	html.SlotEl,
+	html.Small,
+	html.Source,
+	html.Span,
+	html.Strong,
+	html.StyleEl,
+	html.Sub,
+	html.Summary,
+	html.Sup,
+	html.SVG,
+	html.Table,
+	html.TBody,
+	html.Td,
+	html.TemplateEl,
+	html.Textarea,
+	html.TFoot,
+	html.Th,
+	html.THead,
+	html.Time,
+	html.TitleEl,
+	html.Tr,
+	html.Track,
+	html.U,
+	html.Ul,
+	html.Var,
+	html.Video,
+	html.Wbr,
+}
+
+// booleanAttributeHelpers create name-only attributes.
+var booleanAttributeHelpers = []func() html.Node{
+	html.AllowFullscreen,
+	html.Async,
+	html.AutoFocus,
+	html.AutoPlay,
+	html.Checked,
+	html.Controls,
+	html.Default,
+	html.Defer,
+	html.Disabled,
+	html.FormNoValidate,
+	html.Hidden,
+	html.Inert,
+	html.IsMap,
+	html.ItemScope,
+	html.Loop,
+	html.Multiple,
+	html.Muted,
+	html.NoModule,
+	html.NoValidate,
+	html.Open,
+	html.PlaysInline,
+	html.ReadOnly,
+	html.Required,
+	html.Reversed,
+	html.Selected,
+}
+
+// attributeHelpers create attributes with a value.
+var attributeHelpers = []func(string) html.Node{
+	html.AbbrAttr,
+	html.Accept,
+	html.AcceptCharset,
+	html.AccessKey,
+	html.Action,
+	html.Allow,
+	html.Alt,
+	html.As,
+	html.AutoCapitalize,
+	html.AutoComplete,
+	html.Blocking,
+	html.Charset,
+	html.CiteAttr,
+	html.Class,
+	html.Cols,
+	html.ColSpan,
+	html.Content,
+	html.ContentEditable,
+	html.Coords,
+	html.CrossOrigin,
+	html.DateTime,
+	html.Decoding,
+	html.Dir,
+	html.DirName,
+	html.Download,
+	html.Draggable,
+	html.EncType,
+	html.EnterKeyHint,
+	html.FetchPriority,
+	html.For,
+	html.FormAttr,
+	html.FormAction,
+	html.FormEncType,
+	html.FormMethod,
+	html.FormTarget,
+	html.Headers,
+	html.Height,
+	html.High,
+	html.Href,
+	html.HrefLang,
+	html.HTTPEquiv,
+	html.ID,
+	html.ImageSizes,
+	html.ImageSrcSet,
+	html.InputMode,
+	html.Integrity,
+	html.ItemID,
+	html.ItemProp,
+	html.ItemRef,
+	html.ItemType,
+	html.Kind,
+	html.LabelAttr,
+	html.Lang,
+	html.List,
+	html.Loading,
+	html.Low,
+	html.Max,
+	html.MaxLength,
+	html.Media,
+	html.Method,
+	html.Min,
+	html.MinLength,
+	html.Name,
+	html.Nonce,
+	html.Optimum,
+	html.Pattern,
+	html.Ping,
+	html.Placeholder,
+	html.Popover,
+	html.PopoverTarget,
+	html.PopoverTargetAction,
+	html.Poster,
+	html.Preload,
+	html.ReferrerPolicy,
+	html.Rel,
+	html.Role,
+	html.Rows,
+	html.RowSpan,
+	html.Sandbox,
+	html.Scope,
+	html.ShadowRootMode,
+	html.Shape,
+	html.Size,
+	html.Sizes,
+	html.SlotAttr,
+	html.SpanAttr,
+	html.SpellCheck,
+	html.Src,
+	html.SrcDoc,
+	html.SrcLang,
+	html.SrcSet,
+	html.Start,
+	html.Step,
+	html.StyleAttr,
+	html.TabIndex,
+	html.Target,
+	html.TitleAttr,
+	html.Translate,
+	html.Type,
+	html.UseMap,
+	html.Value,
+	html.Width,
+	html.Wrap,
+}
@@ -0,0 +1,91 @@
+// Command html2go converts HTML to Go code using the helpers of github.com/melias122/html.
+//
+// It reads HTML from the given file, or from stdin, and writes a Go file with a function returning the Node:
+//
+//	html2go -func Hero hero.html > hero.go
+//
+// Elements and attributes with a helper, like Div, Href, TitleAttr, DataAttr, and Aria, are converted to calls
+// to the helper, and El and Attr are used for the rest. Whitespace in text is collapsed, except in Pre and Textarea,
+// as it's mostly from indenting the HTML. The package is dot-imported, or imported regularly with -qualified.
+//
+// With -template, or for .gohtml and .tmpl files, html/template files are converted to a function per template,
+// taking a props struct with fields inferred from the template:
+//
+//	html2go -template -func Page page.gohtml > page.go
+//
+// Pipelines are converted to Text and Textf, if to If, or to a function literal if its content reads values,
+// which may be nil pointers that the condition guards against, range to Map, with to a function literal,
+// and template to a call to the function for the template. The comparison functions, not, and, or, len,
+// and printf are supported. Constructs that can't be translated, like other functions and variable declarations,
+// are kept as Raw Nodes with a TODO comment, and printed as warnings.
+// Note that html/template escapes values depending on context, like URLs in attributes, which Text and Attr don't.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, "html2go:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("html2go", flag.ContinueOnError)
+	var opts options
+	fs.StringVar(&opts.packageName, "package", "components", "package name of the generated file")
+	fs.StringVar(&opts.funcName, "func", "Component", "name of the generated function")
+	fs.BoolVar(&opts.qualified, "qualified", false, "import the html package regularly instead of with a dot-import")
+	isTemplate := fs.Bool("template", false, "convert an html/template file, which is the default for .gohtml and .tmpl files")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: html2go [flags] [file]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	r := stdin
+	switch fs.NArg() {
+	case 0:
+	case 1:
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		r = f
+		ext := filepath.Ext(fs.Arg(0))
+		*isTemplate = *isTemplate || ext == ".gohtml" || ext == ".tmpl"
+	default:
+		fs.Usage()
+		return fmt.Errorf("expected at most one file")
+	}
+
+	if !*isTemplate {
+		src, err := convert(r, opts)
+		if err != nil {
+			return err
+		}
+		_, err = stdout.Write(src)
+		return err
+	}
+
+	src, warnings, err := convertTemplate(r, opts)
+	if err != nil {
+		return err
+	}
+	for _, w := range warnings {
+		fmt.Fprintln(stderr, "html2go: warning:", w)
+	}
+	_, err = stdout.Write(src)
+	return err
+}
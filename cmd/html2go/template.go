@@ -0,0 +1,1027 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template/parse"
+	"unicode"
+
+	"github.com/melias122/html"
+)
+
+// Markers stand in for template actions in the HTML, so it can be parsed before the actions are converted.
+const (
+	markerStart = '\ue000'
+	markerEnd   = '\ue001'
+)
+
+// action in a Node list, which stands in for the template action with the index.
+type action int
+
+// Render satisfies html.Node, but actions are never rendered.
+func (a action) Render(io.Writer) error {
+	return nil
+}
+
+// untranslated raw text with template actions in it, like the content of a script element,
+// which is kept as is with a TODO comment.
+type untranslated string
+
+// Render satisfies html.Node, but untranslated text is never rendered.
+func (u untranslated) Render(io.Writer) error {
+	return nil
+}
+
+// convertTemplate converts the html/template templates read from r to gofmt-ed Go source,
+// with a function per template taking a props struct and returning the Node,
+// and warnings for the template constructs it can't translate.
+func convertTemplate(r io.Reader, opts options) ([]byte, []string, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	trees, err := parseTemplate(opts.funcName, string(b))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := &templateConverter{
+		c:     &converter{opts: opts},
+		trees: trees,
+	}
+	t.c.tmpl = t
+	t.props = &shape{name: opts.funcName + "Props", base: opts.funcName}
+
+	// Convert twice, as the first pass finds out the types of the props, which the conversion depends on.
+	t.convert()
+	t.warnings = nil
+	funcs := t.convert()
+
+	var src strings.Builder
+	fmt.Fprintf(&src, "package %v\n\n", opts.packageName)
+	src.WriteString("import (\n")
+	if t.usesFmt {
+		src.WriteString("\"fmt\"\n\n")
+	}
+	if opts.qualified {
+		src.WriteString("\"github.com/melias122/html\"\n")
+	} else {
+		src.WriteString(". \"github.com/melias122/html\"\n")
+	}
+	src.WriteString(")\n\n")
+	t.props.writeTypes(&src)
+	src.WriteString(funcs)
+
+	formatted, err := format.Source([]byte(src.String()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error formatting generated code: %w\n%v", err, src.String())
+	}
+	return formatted, t.warnings, nil
+}
+
+func parseTemplate(name, text string) (map[string]*parse.Tree, error) {
+	tree := parse.New(name)
+	tree.Mode = parse.SkipFuncCheck
+	trees := map[string]*parse.Tree{}
+	if _, err := tree.Parse(text, "", "", trees); err != nil {
+		return nil, err
+	}
+	return trees, nil
+}
+
+// templateConverter converts template actions to Go expressions.
+type templateConverter struct {
+	c        *converter
+	trees    map[string]*parse.Tree
+	tree     *parse.Tree
+	props    *shape
+	actions  []parse.Node
+	scope    *scope
+	usesFmt  bool
+	warnings []string
+}
+
+// scope of template variables and the dot.
+type scope struct {
+	parent *scope
+	dot    value
+	vars   map[string]value
+}
+
+func (s *scope) lookup(name string) (value, bool) {
+	for ; s != nil; s = s.parent {
+		if v, ok := s.vars[name]; ok {
+			return v, true
+		}
+	}
+	return value{}, false
+}
+
+// value is a Go expression and the shape of its value.
+type value struct {
+	expr  string
+	shape *shape
+}
+
+// convert all templates to functions.
+func (t *templateConverter) convert() string {
+	t.actions = nil
+	var names []string
+	for name := range t.trees {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		tree := t.trees[name]
+		if name != t.c.opts.funcName && isEmpty(tree.Root) {
+			continue
+		}
+		t.tree = tree
+		t.scope = &scope{dot: value{expr: "p", shape: t.props}, vars: map[string]value{"$": {expr: "p", shape: t.props}}}
+
+		fn := t.funcName(name)
+		fmt.Fprintf(&b, "// %v is converted from the template %q.\n", fn, name)
+		fmt.Fprintf(&b, "func %v(p %v) %v {\nreturn %v\n}\n\n", fn, t.props.name, t.c.ident("Node"), t.document(tree.Root))
+	}
+	return b.String()
+}
+
+// funcName for a template name, like "UserCard" for "user-card",
+// with "Template" appended if it would clash with an identifier of the html package.
+func (t *templateConverter) funcName(name string) string {
+	if name == t.c.opts.funcName {
+		return name
+	}
+	fn := exportedName(name)
+	if isPackageIdent(fn) {
+		fn += "Template"
+	}
+	return fn
+}
+
+// isPackageIdent returns whether name is an identifier in the html package that generated code may use.
+func isPackageIdent(name string) bool {
+	switch name {
+	case "Aria", "Attr", "DataAttr", "Doctype", "El", "Group", "If", "Map", "Node", "Raw", "Template", "Text", "Textf":
+		return true
+	}
+	for _, m := range []map[string]string{elements, booleanAttributes, attributes} {
+		for _, fn := range m {
+			if fn == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// document converts the list of template nodes of a whole template, which may be an HTML document.
+func (t *templateConverter) document(l *parse.ListNode) string {
+	source := t.source(l)
+	if !isDocument(source) {
+		return t.nodes(source)
+	}
+	n, _ := html.Parse(strings.NewReader(source))
+	return t.c.expressions(t.split([]html.Node{n}))[0]
+}
+
+// list converts a list of template nodes to a single Go expression.
+func (t *templateConverter) list(l *parse.ListNode) string {
+	if l == nil {
+		return "nil"
+	}
+
+	return t.nodes(t.source(l))
+}
+
+// nodes converts HTML with markers to a single Go expression.
+func (t *templateConverter) nodes(source string) string {
+	nodes, _ := html.ParseFragment(strings.NewReader(source))
+	exprs := t.c.expressions(t.c.children(t.split(nodes), false))
+	switch len(exprs) {
+	case 0:
+		return "nil"
+	case 1:
+		return exprs[0]
+	default:
+		return t.c.ident("Group") + "([]" + t.c.ident("Node") + "{\n" + strings.Join(exprs, ",\n") + ",\n})"
+	}
+}
+
+// source of the list as HTML with markers for the actions.
+func (t *templateConverter) source(l *parse.ListNode) string {
+	var b strings.Builder
+	for _, n := range l.Nodes {
+		if n, ok := n.(*parse.TextNode); ok {
+			b.Write(n.Text)
+			continue
+		}
+		b.WriteString(string(markerStart) + strconv.Itoa(len(t.actions)) + string(markerEnd))
+		t.actions = append(t.actions, n)
+	}
+	return b.String()
+}
+
+// split text Nodes with markers into text and actions.
+func (t *templateConverter) split(nodes []html.Node) []html.Node {
+	var result []html.Node
+	for _, n := range nodes {
+		switch n := n.(type) {
+		case html.TextNode:
+			for _, part := range splitMarkers(string(n)) {
+				if i, ok := part.(action); ok {
+					result = append(result, i)
+				} else if part != "" {
+					result = append(result, html.Text(part.(string)))
+				}
+			}
+		case html.RawNode:
+			if strings.ContainsRune(string(n), markerStart) {
+				t.warn(nil, "actions in script and style elements")
+				result = append(result, untranslated(t.restore(string(n))))
+				continue
+			}
+			result = append(result, n)
+		default:
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// splitMarkers in s into strings and actions.
+func splitMarkers(s string) []interface{} {
+	var parts []interface{}
+	for {
+		start := strings.IndexRune(s, markerStart)
+		if start < 0 {
+			return append(parts, s)
+		}
+		end := strings.IndexRune(s[start:], markerEnd) + start
+		i, _ := strconv.Atoi(s[start+len(string(markerStart)) : end])
+		parts = append(parts, s[:start], action(i))
+		s = s[end+len(string(markerEnd)):]
+	}
+}
+
+// restore the template source of actions in s.
+func (t *templateConverter) restore(s string) string {
+	var b strings.Builder
+	for _, part := range splitMarkers(s) {
+		if i, ok := part.(action); ok {
+			b.WriteString(t.actions[i].String())
+		} else {
+			b.WriteString(part.(string))
+		}
+	}
+	return b.String()
+}
+
+// attribute converts an attribute that is a template action, like {{if .Checked}}checked{{end}}.
+func (t *templateConverter) attribute(a *html.Attribute) (string, bool) {
+	parts := splitMarkers(a.Name)
+	if len(parts) == 1 {
+		return "", false
+	}
+	if len(parts) != 3 || parts[0] != "" || parts[2] != "" || !a.Boolean {
+		t.warn(nil, "actions in attribute names")
+		return t.c.ident("Attr") + "(" + quote(t.restore(a.Name)) + ")", true
+	}
+
+	n := t.actions[parts[1].(action)]
+	if n, ok := n.(*parse.IfNode); ok {
+		exprs := t.branches(n.Pipe, n.List, n.ElseList, t.attributes)
+		if len(exprs) == 1 {
+			return exprs[0], true
+		}
+		return t.c.ident("Group") + "([]" + t.c.ident("Node") + "{" + strings.Join(exprs, ", ") + "})", true
+	}
+	t.warn(n, "actions other than if in start tags")
+	return t.c.ident("Attr") + "(" + quote(t.restore(a.Name)) + ")", true
+}
+
+// attributes converts a list of template nodes in a start tag to a single Go expression.
+func (t *templateConverter) attributes(l *parse.ListNode) string {
+	if l == nil {
+		return "nil"
+	}
+	nodes, _ := html.ParseFragment(strings.NewReader("<x " + t.source(l) + ">"))
+	var exprs []string
+	if len(nodes) == 1 {
+		for _, a := range nodes[0].(*html.Element).Attributes() {
+			var b strings.Builder
+			t.c.attribute(&b, a.(*html.Attribute))
+			exprs = append(exprs, b.String())
+		}
+	}
+	switch len(exprs) {
+	case 0:
+		return "nil"
+	case 1:
+		return exprs[0]
+	default:
+		return t.c.ident("Group") + "([]" + t.c.ident("Node") + "{" + strings.Join(exprs, ", ") + "})"
+	}
+}
+
+// value converts an attribute value with actions to a string expression, and returns whether it had any.
+func (t *templateConverter) value(s string) (string, bool) {
+	parts := splitMarkers(s)
+	if len(parts) == 1 {
+		return quote(s), false
+	}
+
+	var exprs []string
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		i, ok := part.(action)
+		if !ok {
+			exprs = append(exprs, quote(part.(string)))
+			continue
+		}
+
+		switch n := t.actions[i].(type) {
+		case *parse.ActionNode:
+			expr, ok := t.print(n)
+			if !ok {
+				t.warn(n, "this pipeline")
+				expr = quote(n.String())
+			}
+			exprs = append(exprs, expr)
+		case *parse.IfNode:
+			cond, ok := t.condition(n.Pipe)
+			if !ok || len(n.Pipe.Decl) > 0 {
+				t.warn(n, "this condition")
+				exprs = append(exprs, quote(n.String()))
+				continue
+			}
+			expr := t.stringValue(n.List)
+			elseExpr := t.stringValue(n.ElseList)
+			exprs = append(exprs, fmt.Sprintf("func() string {\nif %v {\nreturn %v\n}\nreturn %v\n}()", cond, expr, elseExpr))
+		default:
+			t.warn(n, "actions other than pipelines and if in attribute values")
+			exprs = append(exprs, quote(n.String()))
+		}
+	}
+	return strings.Join(exprs, " + "), true
+}
+
+// stringValue converts a list of template nodes in an attribute value to a string expression.
+func (t *templateConverter) stringValue(l *parse.ListNode) string {
+	if l == nil {
+		return `""`
+	}
+	v, _ := t.value(t.source(l))
+	return v
+}
+
+// print converts the pipeline of an action to a string expression.
+func (t *templateConverter) print(n *parse.ActionNode) (string, bool) {
+	if format, args, ok := t.printf(n.Pipe); ok {
+		t.usesFmt = true
+		return "fmt.Sprintf(" + strings.Join(append([]string{format}, args...), ", ") + ")", true
+	}
+	v, ok := t.pipeline(n.Pipe)
+	if !ok {
+		return "", false
+	}
+	v.shape.use(printed)
+	if v.shape.goType() == "string" {
+		return v.expr, true
+	}
+	t.usesFmt = true
+	return "fmt.Sprint(" + v.expr + ")", true
+}
+
+// expressions converts the template action to Go expressions for Nodes.
+func (t *templateConverter) expressions(a action) []string {
+	switch n := t.actions[a].(type) {
+	case *parse.ActionNode:
+		if len(n.Pipe.Decl) > 0 {
+			return []string{t.untranslatable(n, "variable declarations")}
+		}
+		if format, args, ok := t.printf(n.Pipe); ok {
+			return []string{t.c.ident("Textf") + "(" + strings.Join(append([]string{format}, args...), ", ") + ")"}
+		}
+		v, ok := t.pipeline(n.Pipe)
+		if !ok {
+			return []string{t.untranslatable(n, "this pipeline")}
+		}
+		v.shape.use(printed)
+		if v.shape.goType() == "string" {
+			return []string{t.c.ident("Text") + "(" + v.expr + ")"}
+		}
+		return []string{t.c.ident("Textf") + `("%v", ` + v.expr + ")"}
+
+	case *parse.IfNode:
+		return t.branches(n.Pipe, n.List, n.ElseList, t.list)
+
+	case *parse.RangeNode:
+		return t.rangeExpressions(n)
+
+	case *parse.WithNode:
+		if len(n.Pipe.Decl) > 0 {
+			return []string{t.untranslatable(n, "variable declarations")}
+		}
+		v, ok := t.pipeline(n.Pipe)
+		if !ok {
+			return []string{t.untranslatable(n, "this pipeline")}
+		}
+		v.shape.use(with)
+
+		elseExpr := t.list(n.ElseList)
+		t.push(v)
+		expr := t.list(n.List)
+		t.pop()
+		return []string{fmt.Sprintf("func() %v {\nif %v {\nreturn %v\n}\nreturn %v\n}()", t.c.ident("Node"), v.negated(), elseExpr, expr)}
+
+	case *parse.TemplateNode:
+		fn := t.funcName(n.Name)
+		if n.Pipe == nil {
+			return []string{fn + "(" + t.props.name + "{})"}
+		}
+		v, ok := t.pipeline(n.Pipe)
+		if !ok || v.shape != t.props {
+			return []string{t.untranslatable(n, "templates called with something else than the dot")}
+		}
+		if _, ok := t.trees[n.Name]; !ok {
+			t.warn(n, fmt.Sprintf("calls to template %q, which is not defined in this file", n.Name))
+		}
+		return []string{fn + "(" + v.expr + ")"}
+	}
+	return []string{t.untranslatable(t.actions[a], "this action")}
+}
+
+// branches of an if converts to If Nodes or a function literal for the list and else list, converted with fn.
+func (t *templateConverter) branches(pipe *parse.PipeNode, list, elseList *parse.ListNode, fn func(*parse.ListNode) string) []string {
+	if len(pipe.Decl) > 0 {
+		return []string{t.untranslatable(pipe, "variable declarations")}
+	}
+	cond, ok := t.condition(pipe)
+	if !ok {
+		return []string{t.untranslatable(pipe, "this condition")}
+	}
+
+	if elseList == nil {
+		return []string{t.conditional(cond, fn(list))}
+	}
+
+	expr, elseExpr := fn(list), fn(elseList)
+	if !t.readsValues(expr) && !t.readsValues(elseExpr) {
+		return []string{t.c.ident("If") + "(" + cond + ", " + expr + ")", t.c.ident("If") + "(" + negate(cond) + ", " + elseExpr + ")"}
+	}
+	return []string{fmt.Sprintf("func() %v {\nif %v {\nreturn %v\n}\nreturn %v\n}()", t.c.ident("Node"), cond, expr, elseExpr)}
+}
+
+// conditional converts to an If Node with the expression, if the expression doesn't read any values.
+// Otherwise, it's converted to a function literal that only evaluates the expression if the condition is true,
+// since If gets the expression evaluated already, and it may read through a nil pointer that the condition guards against.
+func (t *templateConverter) conditional(cond, expr string) string {
+	if !t.readsValues(expr) {
+		return t.c.ident("If") + "(" + cond + ", " + expr + ")"
+	}
+	return fmt.Sprintf("func() %v {\nif %v {\nreturn %v\n}\nreturn nil\n}()", t.c.ident("Node"), cond, expr)
+}
+
+// readsValues returns whether the Go expression reads the props or a variable in scope.
+func (t *templateConverter) readsValues(expr string) bool {
+	names := map[string]struct{}{"p": {}}
+	for s := t.scope; s != nil; s = s.parent {
+		names[rootIdent(s.dot.expr)] = struct{}{}
+		for _, v := range s.vars {
+			names[rootIdent(v.expr)] = struct{}{}
+		}
+	}
+
+	for i := 0; i < len(expr); {
+		switch c := expr[i]; {
+		case c == '"' || c == '`':
+			// Skip string literals.
+			i++
+			for i < len(expr) && expr[i] != c {
+				if c == '"' && expr[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i++
+			continue
+		case !isIdentRune(rune(c)):
+			i++
+			continue
+		}
+		j := i
+		for j < len(expr) && isIdentRune(rune(expr[j])) {
+			j++
+		}
+		// Skip selectors like .Name, which are fields and not variables.
+		if _, ok := names[expr[i:j]]; ok && (i == 0 || expr[i-1] != '.') {
+			return true
+		}
+		i = j
+	}
+	return false
+}
+
+// rootIdent of a Go expression like p.User.Name, which is p.
+func rootIdent(expr string) string {
+	for i, r := range expr {
+		if !isIdentRune(r) {
+			return expr[:i]
+		}
+	}
+	return expr
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
+func (t *templateConverter) rangeExpressions(n *parse.RangeNode) []string {
+	if len(n.Pipe.Decl) > 1 {
+		return []string{t.untranslatable(n, "range with index variables")}
+	}
+	if len(n.Pipe.Cmds) != 1 {
+		return []string{t.untranslatable(n, "this pipeline")}
+	}
+	v, ok := t.command(n.Pipe.Cmds[0])
+	if !ok {
+		return []string{t.untranslatable(n, "this pipeline")}
+	}
+	v.shape.use(ranged)
+	elem := v.shape.element()
+
+	name := unexportedName(strings.TrimPrefix(elem.name, elem.parentBase))
+	if len(n.Pipe.Decl) == 1 {
+		name = unexportedName(strings.TrimPrefix(n.Pipe.Decl[0].Ident[0], "$"))
+	}
+	for i := 2; t.inScope(name); i++ {
+		name = strings.TrimRight(name, "0123456789") + strconv.Itoa(i)
+	}
+
+	elemValue := value{expr: name, shape: elem}
+	t.push(elemValue)
+	if len(n.Pipe.Decl) == 1 {
+		t.scope.vars[n.Pipe.Decl[0].Ident[0]] = elemValue
+	}
+	body := t.list(n.List)
+	t.pop()
+
+	exprs := []string{fmt.Sprintf("%v(%v(%v, func(%v %v) %v {\nreturn %v\n}))",
+		t.c.ident("Group"), t.c.ident("Map"), v.expr, name, elem.goType(), t.c.ident("Node"), body)}
+	if n.ElseList != nil {
+		exprs = append(exprs, t.conditional(v.negated(), t.list(n.ElseList)))
+	}
+	return exprs
+}
+
+func (t *templateConverter) push(dot value) {
+	t.scope = &scope{parent: t.scope, dot: dot, vars: map[string]value{}}
+}
+
+func (t *templateConverter) pop() {
+	t.scope = t.scope.parent
+}
+
+// inScope returns whether a Go variable name is used in the current scope.
+func (t *templateConverter) inScope(name string) bool {
+	if name == "p" {
+		return true
+	}
+	for s := t.scope; s != nil; s = s.parent {
+		if s.dot.expr == name {
+			return true
+		}
+	}
+	return false
+}
+
+// printf converts a pipeline with printf to a format and arguments.
+func (t *templateConverter) printf(pipe *parse.PipeNode) (string, []string, bool) {
+	if len(pipe.Decl) > 0 || len(pipe.Cmds) == 0 {
+		return "", nil, false
+	}
+
+	last := pipe.Cmds[len(pipe.Cmds)-1]
+	if id, ok := last.Args[0].(*parse.IdentifierNode); !ok || id.Ident != "printf" || len(last.Args) < 2 || len(pipe.Cmds) > 2 {
+		return "", nil, false
+	}
+	format, ok := last.Args[1].(*parse.StringNode)
+	if !ok {
+		return "", nil, false
+	}
+
+	var values []value
+	for _, arg := range last.Args[2:] {
+		v, ok := t.operand(arg)
+		if !ok {
+			return "", nil, false
+		}
+		values = append(values, v)
+	}
+	if len(pipe.Cmds) == 2 {
+		v, ok := t.command(pipe.Cmds[0])
+		if !ok {
+			return "", nil, false
+		}
+		values = append(values, v)
+	}
+
+	verbs := formatVerbs(format.Text)
+	var args []string
+	for i, v := range values {
+		if i < len(verbs) {
+			switch verbs[i] {
+			case 'd', 'x', 'X', 'o', 'b', 'c':
+				v.shape.use(number)
+			case 't':
+				v.shape.use(condition)
+			default:
+				v.shape.use(printed)
+			}
+		}
+		args = append(args, v.expr)
+	}
+	return format.Quoted, args, true
+}
+
+// formatVerbs returns the verbs in a printf format, like 'd' for "%5d".
+func formatVerbs(format string) []rune {
+	var verbs []rune
+	inVerb := false
+	for _, r := range format {
+		switch {
+		case !inVerb && r == '%':
+			inVerb = true
+		case inVerb && r == '%':
+			inVerb = false
+		case inVerb && unicode.IsLetter(r):
+			verbs = append(verbs, r)
+			inVerb = false
+		}
+	}
+	return verbs
+}
+
+// condition converts a pipeline to a boolean expression, with the truthiness rules of templates.
+func (t *templateConverter) condition(pipe *parse.PipeNode) (string, bool) {
+	v, ok := t.pipeline(pipe)
+	if !ok {
+		return "", false
+	}
+	v.shape.use(condition)
+	return v.truthy(), true
+}
+
+// pipeline converts a pipeline without declarations to a value.
+func (t *templateConverter) pipeline(pipe *parse.PipeNode) (value, bool) {
+	if len(pipe.Decl) > 0 || len(pipe.Cmds) != 1 {
+		return value{}, false
+	}
+	return t.command(pipe.Cmds[0])
+}
+
+// command converts a command, which is an operand or a call to one of the functions eq, ne, lt, le, gt, ge, not,
+// and, or, and len.
+func (t *templateConverter) command(cmd *parse.CommandNode) (value, bool) {
+	id, ok := cmd.Args[0].(*parse.IdentifierNode)
+	if !ok {
+		if len(cmd.Args) != 1 {
+			return value{}, false
+		}
+		return t.operand(cmd.Args[0])
+	}
+
+	var args []value
+	for _, arg := range cmd.Args[1:] {
+		v, ok := t.operand(arg)
+		if !ok {
+			return value{}, false
+		}
+		args = append(args, v)
+	}
+
+	boolean := &shape{kinds: condition}
+	switch id.Ident {
+	case "eq", "ne", "lt", "le", "gt", "ge":
+		if len(args) != 2 {
+			return value{}, false
+		}
+		args[0].shape.like(args[1].shape)
+		args[1].shape.like(args[0].shape)
+		op := map[string]string{"eq": "==", "ne": "!=", "lt": "<", "le": "<=", "gt": ">", "ge": ">="}[id.Ident]
+		return value{expr: args[0].expr + " " + op + " " + args[1].expr, shape: boolean}, true
+	case "not":
+		if len(args) != 1 {
+			return value{}, false
+		}
+		args[0].shape.use(condition)
+		return value{expr: negate(args[0].truthy()), shape: boolean}, true
+	case "and", "or":
+		if len(args) < 2 {
+			return value{}, false
+		}
+		var conds []string
+		for _, a := range args {
+			a.shape.use(condition)
+			conds = append(conds, parenthesize(a.truthy()))
+		}
+		op := map[string]string{"and": " && ", "or": " || "}[id.Ident]
+		return value{expr: strings.Join(conds, op), shape: boolean}, true
+	case "len":
+		if len(args) != 1 {
+			return value{}, false
+		}
+		args[0].shape.use(ranged)
+		return value{expr: "len(" + args[0].expr + ")", shape: &shape{kinds: number}}, true
+	}
+	return value{}, false
+}
+
+// operand converts fields, variables, the dot, and constants.
+func (t *templateConverter) operand(n parse.Node) (value, bool) {
+	switch n := n.(type) {
+	case *parse.DotNode:
+		return t.scope.dot, true
+	case *parse.FieldNode:
+		return t.fields(t.scope.dot, n.Ident), true
+	case *parse.VariableNode:
+		v, ok := t.scope.lookup(n.Ident[0])
+		if !ok {
+			return value{}, false
+		}
+		return t.fields(v, n.Ident[1:]), true
+	case *parse.StringNode:
+		return value{expr: n.Quoted, shape: &shape{kinds: printed}}, true
+	case *parse.NumberNode:
+		return value{expr: n.Text, shape: &shape{kinds: number}}, true
+	case *parse.BoolNode:
+		return value{expr: n.String(), shape: &shape{kinds: condition}}, true
+	case *parse.PipeNode:
+		v, ok := t.pipeline(n)
+		v.expr = parenthesize(v.expr)
+		return v, ok
+	}
+	return value{}, false
+}
+
+func (t *templateConverter) fields(v value, names []string) value {
+	for _, name := range names {
+		v = value{expr: v.expr + "." + name, shape: v.shape.field(name)}
+	}
+	return v
+}
+
+// untranslatable returns an expression for a template node that can't be translated, and warns about it.
+func (t *templateConverter) untranslatable(n parse.Node, what string) string {
+	t.warn(n, what)
+	return t.c.ident("Raw") + "(" + quote(n.String()) + ") /* TODO: translate */"
+}
+
+func (t *templateConverter) warn(n parse.Node, what string) {
+	location := t.tree.ParseName
+	source := ""
+	if n != nil {
+		location, source = t.tree.ErrorContext(n)
+		source = ": " + source
+	}
+	t.warnings = append(t.warnings, fmt.Sprintf("%v: cannot translate %v%v", location, what, source))
+}
+
+// isEmpty returns whether a template has only whitespace text, like the main template of a file with only defines.
+func isEmpty(l *parse.ListNode) bool {
+	for _, n := range l.Nodes {
+		if text, ok := n.(*parse.TextNode); !ok || strings.TrimSpace(string(text.Text)) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// kinds of uses of a value in a template, which determine its Go type.
+type kinds int
+
+const (
+	printed = kinds(1 << iota)
+	condition
+	ranged
+	with
+	number
+)
+
+// shape of a value in a template, inferred from how it's used.
+type shape struct {
+	// name of the Go struct type, if the shape has fields, and base for the names of the types of fields.
+	name, base string
+	// parentBase is the base of the parent, which is the prefix of name.
+	parentBase string
+	kinds      kinds
+	fields     []string
+	byName     map[string]*shape
+	elem       *shape
+}
+
+func (s *shape) use(k kinds) {
+	s.kinds |= k
+}
+
+// like makes a shape that is compared to another one have its type, if the shape has no type yet.
+func (s *shape) like(other *shape) {
+	if s.kinds&(printed|number) == 0 && len(s.fields) == 0 {
+		s.kinds |= other.kinds & (printed | number)
+	}
+}
+
+func (s *shape) field(name string) *shape {
+	if f, ok := s.byName[name]; ok {
+		return f
+	}
+	if s.byName == nil {
+		s.byName = map[string]*shape{}
+	}
+	f := &shape{name: s.base + name, base: s.base + name, parentBase: s.base}
+	s.fields = append(s.fields, name)
+	s.byName[name] = f
+	return f
+}
+
+func (s *shape) element() *shape {
+	if s.elem == nil {
+		name := s.parentBase + singular(strings.TrimPrefix(s.name, s.parentBase))
+		s.elem = &shape{name: name, base: name, parentBase: s.parentBase}
+	}
+	return s.elem
+}
+
+func (s *shape) isStruct() bool {
+	return len(s.fields) > 0
+}
+
+func (s *shape) goType() string {
+	switch {
+	case s.kinds&ranged != 0:
+		return "[]" + s.element().goType()
+	case s.isStruct() && s.kinds&(condition|with) != 0:
+		return "*" + s.name
+	case s.isStruct():
+		return s.name
+	case s.kinds&number != 0:
+		return "int"
+	case s.kinds&printed != 0:
+		return "string"
+	case s.kinds&condition != 0:
+		return "bool"
+	}
+	return "string"
+}
+
+// writeTypes of the shape and its fields as Go struct types.
+func (s *shape) writeTypes(w *strings.Builder) {
+	fmt.Fprintf(w, "type %v struct {\n", s.name)
+	for _, name := range s.fields {
+		fmt.Fprintf(w, "%v %v\n", name, s.byName[name].goType())
+	}
+	w.WriteString("}\n\n")
+
+	for _, name := range s.fields {
+		f := s.byName[name]
+		if f.kinds&ranged != 0 {
+			f = f.element()
+		}
+		if f.isStruct() {
+			f.writeTypes(w)
+		}
+	}
+}
+
+// truthy expression for the value, following the rules of templates for conditions.
+func (v value) truthy() string {
+	switch t := v.shape.goType(); {
+	case t == "bool":
+		return v.expr
+	case t == "string":
+		return v.expr + ` != ""`
+	case t == "int":
+		return v.expr + " != 0"
+	case strings.HasPrefix(t, "[]"):
+		return "len(" + v.expr + ") > 0"
+	case strings.HasPrefix(t, "*"):
+		return v.expr + " != nil"
+	}
+	return "true"
+}
+
+func (v value) negated() string {
+	return negate(v.truthy())
+}
+
+// negate a boolean expression.
+func negate(expr string) string {
+	for _, op := range [][2]string{{" != ", " == "}, {" == ", " != "}, {" > 0", " == 0"}} {
+		if isComparison(expr, op[0]) {
+			return strings.Replace(expr, op[0], op[1], 1)
+		}
+	}
+	if isIdentifier(expr) || isParenthesized(expr) {
+		return "!" + expr
+	}
+	if strings.HasPrefix(expr, "!") && isIdentifier(expr[1:]) {
+		return expr[1:]
+	}
+	return "!(" + expr + ")"
+}
+
+// isComparison returns whether expr is a single comparison with the operator op.
+func isComparison(expr, op string) bool {
+	return strings.Count(expr, op) == 1 && !strings.ContainsAny(strings.Replace(expr, op, "", 1), "<>=!&|")
+}
+
+func parenthesize(expr string) string {
+	if !strings.Contains(expr, " ") || isParenthesized(expr) {
+		return expr
+	}
+	return "(" + expr + ")"
+}
+
+// isParenthesized returns whether the whole expr is in parentheses.
+func isParenthesized(expr string) bool {
+	if !strings.HasPrefix(expr, "(") {
+		return false
+	}
+	depth := 0
+	for i, r := range expr {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i == len(expr)-1
+			}
+		}
+	}
+	return false
+}
+
+// isIdentifier returns whether expr is an identifier or selector, like p.User.Name.
+func isIdentifier(expr string) bool {
+	for _, r := range expr {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '.' {
+			return false
+		}
+	}
+	return expr != ""
+}
+
+// singular of an English plural, like "Item" for "Items", or the name with "Item" appended.
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss"):
+		return strings.TrimSuffix(name, "s")
+	}
+	return name + "Item"
+}
+
+// exportedName for a template name, like "UserCard" for "user-card" or "user_card.gohtml".
+func exportedName(name string) string {
+	name = strings.TrimSuffix(name, ".gohtml")
+	name = strings.TrimSuffix(name, ".tmpl")
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() == 0 || unicode.IsDigit([]rune(b.String())[0]) {
+		return "T" + b.String()
+	}
+	return b.String()
+}
+
+// unexportedName for a Go variable, like "item" for "Item".
+func unexportedName(name string) string {
+	if name == "" {
+		return "v"
+	}
+	r := []rune(name)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
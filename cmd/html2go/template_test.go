@@ -0,0 +1,197 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestConvertTemplate(t *testing.T) {
+	t.Run("converts a template file with defines to functions and a props struct", func(t *testing.T) {
+		f, err := os.Open("testdata/page.gohtml")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+
+		src, warnings, err := convertTemplate(f, options{packageName: "pages", funcName: "Page"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected, err := os.ReadFile("testdata/page.go.golden")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(src) != string(expected) {
+			t.Fatalf("expected\n%v\nbut got\n%v", string(expected), string(src))
+		}
+
+		expectedWarnings := []string{
+			"Page:17:9: cannot translate this pipeline: {{upper .Title}}",
+			"Page: cannot translate actions in script and style elements",
+		}
+		if strings.Join(warnings, "\n") != strings.Join(expectedWarnings, "\n") {
+			t.Fatal(warnings)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"pipelines to Text and Textf", `<p>{{.Name}} {{printf "%d/%v" .Count .Total}}</p>`,
+			`P(
+		Text(p.Name),
+		Text(" "),
+		Textf("%d/%v", p.Count, p.Total),
+	)`},
+		{"if and else without values to If", `{{if not .Hidden}}<p>a</p>{{else}}<p>b</p>{{end}}`,
+			`Group([]Node{
+		If(!p.Hidden, P(Text("a"))),
+		If(p.Hidden, P(Text("b"))),
+	})`},
+		{"if and else with values to a function", `{{if not .Hidden}}<p>a</p>{{else if .Other}}<p>b</p>{{else}}<p>{{.Text}}</p>{{end}}`,
+			`func() Node {
+		if !p.Hidden {
+			return P(Text("a"))
+		}
+		return func() Node {
+			if p.Other {
+				return P(Text("b"))
+			}
+			return P(
+				Text(p.Text),
+			)
+		}()
+	}()`},
+		{"if guarding a pointer to a function", `{{if .User}}<p>{{.User.Name}}</p>{{end}}`,
+			`func() Node {
+		if p.User != nil {
+			return P(
+				Text(p.User.Name),
+			)
+		}
+		return nil
+	}()`},
+		{"range with variable and conditions", `<ul>{{range $tag := .Tags}}<li>{{$tag}}{{if and $.Admin (gt (len $.Tags) 1)}}x{{end}}</li>{{end}}</ul>`,
+			`Ul(
+		Group(Map(p.Tags, func(tag string) Node {
+			return Li(
+				Text(tag),
+				If(p.Admin && (len(p.Tags) > 1), Text("x")),
+			)
+		})),
+	)`},
+		{"attribute values", `<a href="/users/{{.ID}}" class="link {{.Class}}">x</a>`,
+			`A(Href("/users/"+p.ID), Class("link "+p.Class), Text("x"))`},
+		{"actions in script elements to Raw with a TODO", `<script>var x = {{.X}};</script>`,
+			`Script(
+		Raw("var x = {{.X}};"), /* TODO: translate */
+	)`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			src, _, err := convertTemplate(strings.NewReader(test.template), options{packageName: "components", funcName: "Component"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := "func Component(p ComponentProps) Node {\n\treturn " + test.expected + "\n}\n"
+			if !strings.HasSuffix(string(src), expected) {
+				t.Fatalf("expected suffix\n%v\nbut got\n%v", expected, string(src))
+			}
+		})
+	}
+
+	t.Run("converts to code that renders with nil pointers that conditions guard against", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip("builds the converted code")
+		}
+
+		root, err := filepath.Abs("../..")
+		if err != nil {
+			t.Fatal(err)
+		}
+		page, err := os.ReadFile("testdata/page.go.golden")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dir := t.TempDir()
+		files := map[string]string{
+			"go.mod": "module render\n\ngo 1.18\n\nrequire github.com/melias122/html v0.0.0\n\n" +
+				"replace github.com/melias122/html => " + root + "\n",
+			"main.go": `package main
+
+import (
+	"os"
+
+	"render/pages"
+)
+
+func main() {
+	if err := pages.Page(pages.PageProps{Title: "Hats"}).Render(os.Stdout); err != nil {
+		panic(err)
+	}
+}
+`,
+			filepath.Join("pages", "page.go"): string(page),
+		}
+		for name, content := range files {
+			if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		cmd := exec.Command(filepath.Join(runtime.GOROOT(), "bin", "go"), "run", ".")
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("%v:\n%s", err, out)
+		}
+		if !strings.Contains(string(out), `<header><h1>Hats</h1></header>`) || !strings.Contains(string(out), `<a href="/login">Log in</a>`) {
+			t.Fatal(string(out))
+		}
+	})
+
+	t.Run("infers types of props", func(t *testing.T) {
+		src, _, err := convertTemplate(strings.NewReader(`{{if .Items}}{{range .Items}}{{.Title}}{{end}}{{end}}{{if eq .Count 3}}{{end}}{{with .User}}{{.Name}}{{end}}`),
+			options{packageName: "components", funcName: "List"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := `type ListProps struct {
+	Items []ListItem
+	Count int
+	User  *ListUser
+}
+
+type ListItem struct {
+	Title string
+}
+
+type ListUser struct {
+	Name string
+}
+`
+		if !strings.Contains(string(src), expected) {
+			t.Fatalf("expected\n%v\nin\n%v", expected, string(src))
+		}
+	})
+
+	t.Run("returns template parse errors", func(t *testing.T) {
+		if _, _, err := convertTemplate(strings.NewReader(`{{if .A}}`), options{packageName: "components", funcName: "Component"}); err == nil {
+			t.FailNow()
+		}
+	})
+}
@@ -0,0 +1,264 @@
+package html
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Deferred creates a Node that is loaded with the given load function.
+//
+// If it's rendered with a context from WithDeferred, it renders the fallback Node inside a placeholder element
+// right away, and calls load in the background. The loaded Node is rendered later, together with a small inline
+// script that replaces the placeholder with it: by DeferredContent, such as at the end of the body element,
+// or by Deferrals.Render after the rest of the page.
+// If load returns an error or panics, the fallback stays in place. The script gets the nonce from WithNonce.
+//
+// Otherwise, load is called when the Node is rendered, and its result is rendered in place.
+// Errors from load are then returned from Render.
+func Deferred(fallback Node, load func(ctx context.Context) (Node, error)) Node {
+	return &deferred{fallback: fallback, load: load}
+}
+
+type deferred struct {
+	fallback Node
+	load     func(ctx context.Context) (Node, error)
+}
+
+// Render satisfies Node.
+func (d *deferred) Render(w io.Writer) error {
+	return d.RenderContext(context.Background(), w)
+}
+
+// RenderContext satisfies ContextRenderer.
+func (d *deferred) RenderContext(ctx context.Context, w io.Writer) error {
+	ds, ok := ctx.Value(deferralsContextKey{}).(*Deferrals)
+	if !ok {
+		n, err := d.load(ctx)
+		if err != nil || n == nil {
+			return err
+		}
+		return RenderCtx(ctx, n, w)
+	}
+
+	id := ds.start(ctx, d.load)
+	if _, err := w.Write([]byte(`<html-deferred id="` + id + `">`)); err != nil {
+		return err
+	}
+	if d.fallback != nil {
+		if err := RenderCtx(ctx, d.fallback, w); err != nil {
+			return err
+		}
+	}
+	_, err := w.Write([]byte("</html-deferred>"))
+	return err
+}
+
+// Type satisfies nodeTypeDescriber.
+func (d *deferred) Type() NodeType {
+	return ElementType
+}
+
+// String satisfies fmt.Stringer.
+func (d *deferred) String() string {
+	var b strings.Builder
+	_ = d.Render(&b)
+	return b.String()
+}
+
+type deferralsContextKey struct{}
+
+// Deferrals keeps track of the Deferred Nodes rendered with a context from WithDeferred.
+type Deferrals struct {
+	mu      sync.Mutex
+	next    int
+	pending int
+	ready   []deferredResult
+	notify  chan struct{}
+	// err is the first error of a Node that failed to load or render.
+	err error
+}
+
+type deferredResult struct {
+	id  string
+	n   Node
+	err error
+}
+
+// WithDeferred returns a copy of ctx in which Deferred Nodes render their fallback and load in the background,
+// and the Deferrals to render the loaded Nodes with.
+// Call Deferrals.Render after the rest of the page has been rendered, for Deferred Nodes not rendered by DeferredContent.
+// The load functions are called with ctx, so cancelling it cancels loading.
+func WithDeferred(ctx context.Context) (context.Context, *Deferrals) {
+	ds := &Deferrals{notify: make(chan struct{}, 1)}
+	return context.WithValue(ctx, deferralsContextKey{}, ds), ds
+}
+
+type nonceContextKey struct{}
+
+// WithNonce returns a copy of ctx with the nonce of the Content Security Policy of the page,
+// which is added to the scripts that Deferrals render. Use NonceFromContext to add it to other elements.
+func WithNonce(ctx context.Context, nonce string) context.Context {
+	return context.WithValue(ctx, nonceContextKey{}, nonce)
+}
+
+// NonceFromContext returns the nonce from WithNonce, or the empty string if there is none.
+func NonceFromContext(ctx context.Context) string {
+	nonce, _ := ctx.Value(nonceContextKey{}).(string)
+	return nonce
+}
+
+// start loading in the background, and return the placeholder ID.
+func (ds *Deferrals) start(ctx context.Context, load func(ctx context.Context) (Node, error)) string {
+	ds.mu.Lock()
+	id := "html-deferred-" + strconv.Itoa(ds.next)
+	ds.next++
+	ds.pending++
+	ds.mu.Unlock()
+
+	go func() {
+		n, err := loadRecover(ctx, load)
+
+		ds.mu.Lock()
+		ds.ready = append(ds.ready, deferredResult{id: id, n: n, err: err})
+		ds.mu.Unlock()
+
+		select {
+		case ds.notify <- struct{}{}:
+		default:
+		}
+	}()
+
+	return id
+}
+
+// loadRecover calls load, and returns a panic in it as an error, so it fails like a load error
+// instead of crashing the program from the background goroutine.
+func loadRecover(ctx context.Context, load func(ctx context.Context) (Node, error)) (n Node, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			n, err = nil, fmt.Errorf("html: deferred load panicked: %v", r)
+		}
+	}()
+	return load(ctx)
+}
+
+// Pending returns whether any Deferred Nodes have not been rendered by Render yet.
+func (ds *Deferrals) Pending() bool {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	return ds.pending > 0
+}
+
+// Render the loaded Deferred Nodes to w in the order they finish loading, each followed by a script that
+// moves it into its placeholder. If w supports flushing, it's flushed before waiting for the first Node,
+// and after each one. See Flush.
+// Render returns when all Deferred Nodes, including ones rendered by other Deferred Nodes, are done,
+// or with ctx.Err() when ctx is done.
+// A Node that fails to load or render is skipped, and the first such error is returned after the others are done.
+//
+// Render only has to render the Deferred Nodes that DeferredContent hasn't, but still returns the first error
+// of the ones rendered by DeferredContent.
+func (ds *Deferrals) Render(ctx context.Context, w io.Writer) error {
+	if err := ds.render(ctx, w); err != nil {
+		return err
+	}
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	return ds.err
+}
+
+// render the loaded Deferred Nodes like Render, but only return errors from ctx and from writing to w.
+// Errors of single Nodes are kept for Render to return.
+func (ds *Deferrals) render(ctx context.Context, w io.Writer) error {
+	if !ds.Pending() {
+		return nil
+	}
+	if err := Flush().Render(w); err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	for {
+		ds.mu.Lock()
+		if len(ds.ready) == 0 {
+			pending := ds.pending
+			ds.mu.Unlock()
+			if pending == 0 {
+				return nil
+			}
+			select {
+			case <-ds.notify:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			continue
+		}
+		r := ds.ready[0]
+		ds.ready = ds.ready[1:]
+		ds.mu.Unlock()
+
+		b.Reset()
+		err := r.err
+		if err == nil {
+			err = renderDeferred(ctx, &b, r)
+		}
+
+		ds.mu.Lock()
+		ds.pending--
+		if err != nil && ds.err == nil {
+			ds.err = err
+		}
+		ds.mu.Unlock()
+
+		if err != nil {
+			continue
+		}
+
+		if _, err := b.WriteTo(w); err != nil {
+			return err
+		}
+		if err := Flush().Render(w); err != nil {
+			return err
+		}
+	}
+}
+
+// DeferredContent returns a Node that renders the loaded Deferred Nodes like Deferrals.Render,
+// if it's rendered with a context from WithDeferred, and nothing otherwise.
+// Place it at the end of the body element, so the loaded Nodes are streamed inside it, like HTML5 does.
+// Errors of single Deferred Nodes are not returned from rendering it, but from Deferrals.Render.
+func DeferredContent() Node {
+	return ContextNodeFunc(func(ctx context.Context, w io.Writer) error {
+		ds, ok := ctx.Value(deferralsContextKey{}).(*Deferrals)
+		if !ok {
+			return nil
+		}
+		return ds.render(ctx, w)
+	})
+}
+
+// renderDeferred renders the loaded Node r in a template element, and the script to move it to its placeholder.
+func renderDeferred(ctx context.Context, w io.Writer, r deferredResult) error {
+	if _, err := w.Write([]byte(`<template id="` + r.id + `-content">`)); err != nil {
+		return err
+	}
+	if r.n != nil {
+		if err := RenderCtx(ctx, r.n, w); err != nil {
+			return err
+		}
+	}
+	script := `</template><script>`
+	if nonce := NonceFromContext(ctx); nonce != "" {
+		script = `</template><script` + (&Attribute{Name: "nonce", Value: nonce}).String() + `>`
+	}
+	_, err := w.Write([]byte(script + `(function(){` +
+		`var p=document.getElementById("` + r.id + `"),t=document.getElementById("` + r.id + `-content");` +
+		`if(p&&t){p.replaceWith(t.content)}if(t){t.remove()}document.currentScript.remove()` +
+		`})()</script>`))
+	return err
+}
@@ -0,0 +1,293 @@
+package html
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func script(id string) string {
+	return `<script>(function(){var p=document.getElementById("` + id + `"),t=document.getElementById("` + id + `-content");` +
+		`if(p&&t){p.replaceWith(t.content)}if(t){t.remove()}document.currentScript.remove()})()</script>`
+}
+
+func TestDeferred(t *testing.T) {
+	t.Run("loads and renders in place without deferrals", func(t *testing.T) {
+		n := El("div", Deferred(Text("Loading…"), func(ctx context.Context) (Node, error) {
+			return El("span", Text("hat")), nil
+		}))
+		Equal(t, "<div><span>hat</span></div>", n)
+	})
+
+	t.Run("returns load errors without deferrals", func(t *testing.T) {
+		n := El("div", Deferred(Text("Loading…"), func(ctx context.Context) (Node, error) {
+			return nil, errors.New("no hats")
+		}))
+		Error(t, n.Render(&strings.Builder{}))
+	})
+
+	t.Run("renders the fallback in a placeholder, and the loaded node later", func(t *testing.T) {
+		ctx, ds := WithDeferred(context.Background())
+		n := El("div", Deferred(Text("Loading…"), func(ctx context.Context) (Node, error) {
+			return El("span", Text("hat")), nil
+		}), El("p"))
+
+		var b strings.Builder
+		if err := RenderCtx(ctx, n, &b); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != `<div><html-deferred id="html-deferred-0">Loading…</html-deferred><p></p></div>` {
+			t.Fatal("got", b.String())
+		}
+		b.Reset()
+
+		if err := ds.Render(ctx, &b); err != nil {
+			t.Fatal(err)
+		}
+		expected := `<template id="html-deferred-0-content"><span>hat</span></template>` + script("html-deferred-0")
+		if b.String() != expected {
+			t.Fatal("got", b.String())
+		}
+		if ds.Pending() {
+			t.Fatal("still pending")
+		}
+	})
+
+	t.Run("renders loaded nodes in the order they finish loading", func(t *testing.T) {
+		ctx, ds := WithDeferred(context.Background())
+		firstDone := make(chan struct{})
+		n := El("div",
+			Deferred(nil, func(ctx context.Context) (Node, error) {
+				<-firstDone
+				return Text("slow"), nil
+			}),
+			Deferred(nil, func(ctx context.Context) (Node, error) {
+				defer close(firstDone)
+				return Text("fast"), nil
+			}),
+		)
+		if err := RenderCtx(ctx, n, &strings.Builder{}); err != nil {
+			t.Fatal(err)
+		}
+
+		var b strings.Builder
+		if err := ds.Render(ctx, &b); err != nil {
+			t.Fatal(err)
+		}
+		expected := `<template id="html-deferred-1-content">fast</template>` + script("html-deferred-1") +
+			`<template id="html-deferred-0-content">slow</template>` + script("html-deferred-0")
+		if b.String() != expected {
+			t.Fatal("got", b.String())
+		}
+	})
+
+	t.Run("renders deferred nodes inside loaded nodes", func(t *testing.T) {
+		ctx, ds := WithDeferred(context.Background())
+		n := Deferred(nil, func(ctx context.Context) (Node, error) {
+			return Deferred(Text("inner fallback"), func(ctx context.Context) (Node, error) {
+				return Text("inner"), nil
+			}), nil
+		})
+		if err := RenderCtx(ctx, n, &strings.Builder{}); err != nil {
+			t.Fatal(err)
+		}
+
+		var b strings.Builder
+		if err := ds.Render(ctx, &b); err != nil {
+			t.Fatal(err)
+		}
+		expected := `<template id="html-deferred-0-content"><html-deferred id="html-deferred-1">inner fallback</html-deferred></template>` +
+			script("html-deferred-0") +
+			`<template id="html-deferred-1-content">inner</template>` + script("html-deferred-1")
+		if b.String() != expected {
+			t.Fatal("got", b.String())
+		}
+	})
+
+	t.Run("skips nodes that fail to load and returns the error after the others", func(t *testing.T) {
+		ctx, ds := WithDeferred(context.Background())
+		failed := make(chan struct{})
+		n := El("div",
+			Deferred(Text("fallback"), func(ctx context.Context) (Node, error) {
+				defer close(failed)
+				return nil, errors.New("no hats")
+			}),
+			Deferred(nil, func(ctx context.Context) (Node, error) {
+				<-failed
+				return Text("hat"), nil
+			}),
+		)
+		if err := RenderCtx(ctx, n, &strings.Builder{}); err != nil {
+			t.Fatal(err)
+		}
+
+		var b strings.Builder
+		err := ds.Render(ctx, &b)
+		if err == nil || err.Error() != "no hats" {
+			t.Fatal("error is", err)
+		}
+		expected := `<template id="html-deferred-1-content">hat</template>` + script("html-deferred-1")
+		if b.String() != expected {
+			t.Fatal("got", b.String())
+		}
+	})
+
+	t.Run("renders loaded nodes with DeferredContent", func(t *testing.T) {
+		ctx, ds := WithDeferred(context.Background())
+		n := Doctype(El("html", El("body", Deferred(Text("Loading…"), func(ctx context.Context) (Node, error) {
+			return Text("hat"), nil
+		}), El("p"), DeferredContent())))
+
+		var b strings.Builder
+		if err := RenderCtx(ctx, n, &b); err != nil {
+			t.Fatal(err)
+		}
+		expected := `<!doctype html><html><body><html-deferred id="html-deferred-0">Loading…</html-deferred><p></p>` +
+			`<template id="html-deferred-0-content">hat</template>` + script("html-deferred-0") + `</body></html>`
+		if b.String() != expected {
+			t.Fatal("got", b.String())
+		}
+		if ds.Pending() {
+			t.Fatal("still pending")
+		}
+		if err := ds.Render(ctx, &b); err != nil || b.String() != expected {
+			t.Fatal("rendered again", b.String(), err)
+		}
+	})
+
+	t.Run("returns errors of nodes rendered by DeferredContent from Render", func(t *testing.T) {
+		ctx, ds := WithDeferred(context.Background())
+		n := El("body", Deferred(Text("fallback"), func(ctx context.Context) (Node, error) {
+			return nil, errors.New("no hats")
+		}), DeferredContent())
+
+		var b strings.Builder
+		if err := RenderCtx(ctx, n, &b); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != `<body><html-deferred id="html-deferred-0">fallback</html-deferred></body>` {
+			t.Fatal("got", b.String())
+		}
+		if err := ds.Render(ctx, &b); err == nil || err.Error() != "no hats" {
+			t.Fatal("error is", err)
+		}
+	})
+
+	t.Run("leaves loaded nodes to Render without DeferredContent", func(t *testing.T) {
+		ctx, ds := WithDeferred(context.Background())
+		n := El("body", Deferred(nil, func(ctx context.Context) (Node, error) {
+			return Text("hat"), nil
+		}))
+
+		var b strings.Builder
+		if err := RenderCtx(ctx, n, &b); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != `<body><html-deferred id="html-deferred-0"></html-deferred></body>` || !ds.Pending() {
+			t.Fatal("got", b.String())
+		}
+		if err := ds.Render(ctx, &b); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("DeferredContent renders nothing without a context from WithDeferred", func(t *testing.T) {
+		Equal(t, ``, DeferredContent())
+	})
+
+	t.Run("returns a panic in load as the error of the node", func(t *testing.T) {
+		ctx, ds := WithDeferred(context.Background())
+		n := El("div",
+			Deferred(Text("fallback"), func(ctx context.Context) (Node, error) {
+				panic("no hats")
+			}),
+		)
+		if err := RenderCtx(ctx, n, &strings.Builder{}); err != nil {
+			t.Fatal(err)
+		}
+
+		var b strings.Builder
+		err := ds.Render(ctx, &b)
+		if err == nil || err.Error() != "html: deferred load panicked: no hats" {
+			t.Fatal("error is", err)
+		}
+		if b.String() != "" {
+			t.Fatal("got", b.String())
+		}
+	})
+
+	t.Run("adds the nonce from the context to the script", func(t *testing.T) {
+		ctx, ds := WithDeferred(WithNonce(context.Background(), `r4nd"m`))
+		if err := RenderCtx(ctx, Deferred(nil, func(ctx context.Context) (Node, error) {
+			return Text("hat"), nil
+		}), &strings.Builder{}); err != nil {
+			t.Fatal(err)
+		}
+
+		var b strings.Builder
+		if err := ds.Render(ctx, &b); err != nil {
+			t.Fatal(err)
+		}
+		expected := `<template id="html-deferred-0-content">hat</template>` +
+			strings.Replace(script("html-deferred-0"), "<script>", `<script nonce="r4nd&#34;m">`, 1)
+		if b.String() != expected {
+			t.Fatal("got", b.String())
+		}
+	})
+
+	t.Run("flushes before waiting and after each loaded node", func(t *testing.T) {
+		ctx, ds := WithDeferred(context.Background())
+		n := Deferred(nil, func(ctx context.Context) (Node, error) {
+			return Text("hat"), nil
+		})
+
+		var w flushingWriter
+		if err := RenderCtx(ctx, n, &w); err != nil {
+			t.Fatal(err)
+		}
+		if err := ds.Render(ctx, &w); err != nil {
+			t.Fatal(err)
+		}
+		if len(w.flushed) != 2 || w.flushed[0] != `<html-deferred id="html-deferred-0"></html-deferred>` {
+			t.Fatal("flushed", w.flushed)
+		}
+	})
+
+	t.Run("returns the context error when the context is done while waiting", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ctx, ds := WithDeferred(ctx)
+		n := Deferred(nil, func(ctx context.Context) (Node, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+		if err := RenderCtx(ctx, n, &strings.Builder{}); err != nil {
+			t.Fatal(err)
+		}
+		cancel()
+
+		if err := ds.Render(ctx, &strings.Builder{}); !errors.Is(err, context.Canceled) {
+			t.Fatal("error is", err)
+		}
+	})
+
+	t.Run("renders nothing if nothing is deferred", func(t *testing.T) {
+		ctx, ds := WithDeferred(context.Background())
+		var w flushingWriter
+		if err := ds.Render(ctx, &w); err != nil {
+			t.Fatal(err)
+		}
+		if w.String() != "" || len(w.flushed) != 0 {
+			t.Fatal("got", w.String(), w.flushed)
+		}
+	})
+}
+
+func ExampleDeferred() {
+	e := El("div", Deferred(Text("Loading hats…"), func(ctx context.Context) (Node, error) {
+		return El("span", Text("Party hat")), nil
+	}))
+	_ = e.Render(os.Stdout)
+	// Output: <div><span>Party hat</span></div>
+}
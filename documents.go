@@ -12,6 +12,7 @@ type HTML5Props struct {
 }
 
 // HTML5 document template.
+// The body ends with DeferredContent, so Deferred Nodes are streamed inside it, see Deferred.
 func HTML5(p HTML5Props) Node {
 	return Doctype(
 		HTML(If(p.Language != "", Lang(p.Language)),
@@ -22,7 +23,7 @@ func HTML5(p HTML5Props) Node {
 				If(p.Description != "", Meta(Name("description"), Content(p.Description))),
 				Group(p.Head),
 			),
-			Body(Group(p.Body)),
+			Body(Group(p.Body), DeferredContent()),
 		),
 	)
 }
@@ -0,0 +1,573 @@
+// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package html
+
+// A returns an a element with the given children.
+func A(children ...Node) Node {
+	return El("a", children...)
+}
+
+// Abbr returns an abbr element with the given children.
+func Abbr(children ...Node) Node {
+	return El("abbr", children...)
+}
+
+// Address returns an address element with the given children.
+func Address(children ...Node) Node {
+	return El("address", children...)
+}
+
+// Area returns an area element. It's a void element, so only attribute children are rendered.
+func Area(children ...Node) Node {
+	return El("area", children...)
+}
+
+// Article returns an article element with the given children.
+func Article(children ...Node) Node {
+	return El("article", children...)
+}
+
+// Aside returns an aside element with the given children.
+func Aside(children ...Node) Node {
+	return El("aside", children...)
+}
+
+// Audio returns an audio element with the given children.
+func Audio(children ...Node) Node {
+	return El("audio", children...)
+}
+
+// B returns a b element with the given children.
+func B(children ...Node) Node {
+	return El("b", children...)
+}
+
+// Base returns a base element. It's a void element, so only attribute children are rendered.
+func Base(children ...Node) Node {
+	return El("base", children...)
+}
+
+// Bdi returns a bdi element with the given children.
+func Bdi(children ...Node) Node {
+	return El("bdi", children...)
+}
+
+// Bdo returns a bdo element with the given children.
+func Bdo(children ...Node) Node {
+	return El("bdo", children...)
+}
+
+// BlockQuote returns a blockquote element with the given children.
+func BlockQuote(children ...Node) Node {
+	return El("blockquote", children...)
+}
+
+// Body returns a body element with the given children.
+func Body(children ...Node) Node {
+	return El("body", children...)
+}
+
+// Br returns a br element. It's a void element, so only attribute children are rendered.
+func Br(children ...Node) Node {
+	return El("br", children...)
+}
+
+// Button returns a button element with the given children.
+func Button(children ...Node) Node {
+	return El("button", children...)
+}
+
+// Canvas returns a canvas element with the given children.
+func Canvas(children ...Node) Node {
+	return El("canvas", children...)
+}
+
+// Caption returns a caption element with the given children.
+func Caption(children ...Node) Node {
+	return El("caption", children...)
+}
+
+// Cite returns a cite element with the given children.
+func Cite(children ...Node) Node {
+	return El("cite", children...)
+}
+
+// Code returns a code element with the given children.
+func Code(children ...Node) Node {
+	return El("code", children...)
+}
+
+// Col returns a col element. It's a void element, so only attribute children are rendered.
+func Col(children ...Node) Node {
+	return El("col", children...)
+}
+
+// ColGroup returns a colgroup element with the given children.
+func ColGroup(children ...Node) Node {
+	return El("colgroup", children...)
+}
+
+// DataEl returns a data element with the given children.
+func DataEl(children ...Node) Node {
+	return El("data", children...)
+}
+
+// DataList returns a datalist element with the given children.
+func DataList(children ...Node) Node {
+	return El("datalist", children...)
+}
+
+// Dd returns a dd element with the given children.
+func Dd(children ...Node) Node {
+	return El("dd", children...)
+}
+
+// Del returns a del element with the given children.
+func Del(children ...Node) Node {
+	return El("del", children...)
+}
+
+// Details returns a details element with the given children.
+func Details(children ...Node) Node {
+	return El("details", children...)
+}
+
+// Dfn returns a dfn element with the given children.
+func Dfn(children ...Node) Node {
+	return El("dfn", children...)
+}
+
+// Dialog returns a dialog element with the given children.
+func Dialog(children ...Node) Node {
+	return El("dialog", children...)
+}
+
+// Div returns a div element with the given children.
+func Div(children ...Node) Node {
+	return El("div", children...)
+}
+
+// Dl returns a dl element with the given children.
+func Dl(children ...Node) Node {
+	return El("dl", children...)
+}
+
+// Dt returns a dt element with the given children.
+func Dt(children ...Node) Node {
+	return El("dt", children...)
+}
+
+// Em returns an em element with the given children.
+func Em(children ...Node) Node {
+	return El("em", children...)
+}
+
+// Embed returns an embed element. It's a void element, so only attribute children are rendered.
+func Embed(children ...Node) Node {
+	return El("embed", children...)
+}
+
+// FieldSet returns a fieldset element with the given children.
+func FieldSet(children ...Node) Node {
+	return El("fieldset", children...)
+}
+
+// FigCaption returns a figcaption element with the given children.
+func FigCaption(children ...Node) Node {
+	return El("figcaption", children...)
+}
+
+// Figure returns a figure element with the given children.
+func Figure(children ...Node) Node {
+	return El("figure", children...)
+}
+
+// Footer returns a footer element with the given children.
+func Footer(children ...Node) Node {
+	return El("footer", children...)
+}
+
+// FormEl returns a form element with the given children.
+func FormEl(children ...Node) Node {
+	return El("form", children...)
+}
+
+// H1 returns a h1 element with the given children.
+func H1(children ...Node) Node {
+	return El("h1", children...)
+}
+
+// H2 returns a h2 element with the given children.
+func H2(children ...Node) Node {
+	return El("h2", children...)
+}
+
+// H3 returns a h3 element with the given children.
+func H3(children ...Node) Node {
+	return El("h3", children...)
+}
+
+// H4 returns a h4 element with the given children.
+func H4(children ...Node) Node {
+	return El("h4", children...)
+}
+
+// H5 returns a h5 element with the given children.
+func H5(children ...Node) Node {
+	return El("h5", children...)
+}
+
+// H6 returns a h6 element with the given children.
+func H6(children ...Node) Node {
+	return El("h6", children...)
+}
+
+// Head returns a head element with the given children.
+func Head(children ...Node) Node {
+	return El("head", children...)
+}
+
+// Header returns a header element with the given children.
+func Header(children ...Node) Node {
+	return El("header", children...)
+}
+
+// HGroup returns a hgroup element with the given children.
+func HGroup(children ...Node) Node {
+	return El("hgroup", children...)
+}
+
+// Hr returns a hr element. It's a void element, so only attribute children are rendered.
+func Hr(children ...Node) Node {
+	return El("hr", children...)
+}
+
+// HTML returns a html element with the given children.
+func HTML(children ...Node) Node {
+	return El("html", children...)
+}
+
+// I returns an i element with the given children.
+func I(children ...Node) Node {
+	return El("i", children...)
+}
+
+// IFrame returns an iframe element with the given children.
+func IFrame(children ...Node) Node {
+	return El("iframe", children...)
+}
+
+// Img returns an img element. It's a void element, so only attribute children are rendered.
+func Img(children ...Node) Node {
+	return El("img", children...)
+}
+
+// Input returns an input element. It's a void element, so only attribute children are rendered.
+func Input(children ...Node) Node {
+	return El("input", children...)
+}
+
+// Ins returns an ins element with the given children.
+func Ins(children ...Node) Node {
+	return El("ins", children...)
+}
+
+// Kbd returns a kbd element with the given children.
+func Kbd(children ...Node) Node {
+	return El("kbd", children...)
+}
+
+// Label returns a label element with the given children.
+func Label(children ...Node) Node {
+	return El("label", children...)
+}
+
+// Legend returns a legend element with the given children.
+func Legend(children ...Node) Node {
+	return El("legend", children...)
+}
+
+// Li returns a li element with the given children.
+func Li(children ...Node) Node {
+	return El("li", children...)
+}
+
+// Link returns a link element. It's a void element, so only attribute children are rendered.
+func Link(children ...Node) Node {
+	return El("link", children...)
+}
+
+// Main returns a main element with the given children.
+func Main(children ...Node) Node {
+	return El("main", children...)
+}
+
+// MapEl returns a map element with the given children.
+func MapEl(children ...Node) Node {
+	return El("map", children...)
+}
+
+// Mark returns a mark element with the given children.
+func Mark(children ...Node) Node {
+	return El("mark", children...)
+}
+
+// Menu returns a menu element with the given children.
+func Menu(children ...Node) Node {
+	return El("menu", children...)
+}
+
+// Meta returns a meta element. It's a void element, so only attribute children are rendered.
+func Meta(children ...Node) Node {
+	return El("meta", children...)
+}
+
+// Meter returns a meter element with the given children.
+func Meter(children ...Node) Node {
+	return El("meter", children...)
+}
+
+// Nav returns a nav element with the given children.
+func Nav(children ...Node) Node {
+	return El("nav", children...)
+}
+
+// NoScript returns a noscript element with the given children.
+func NoScript(children ...Node) Node {
+	return El("noscript", children...)
+}
+
+// Object returns an object element with the given children.
+func Object(children ...Node) Node {
+	return El("object", children...)
+}
+
+// Ol returns an ol element with the given children.
+func Ol(children ...Node) Node {
+	return El("ol", children...)
+}
+
+// OptGroup returns an optgroup element with the given children.
+func OptGroup(children ...Node) Node {
+	return El("optgroup", children...)
+}
+
+// Option returns an option element with the given children.
+func Option(children ...Node) Node {
+	return El("option", children...)
+}
+
+// Output returns an output element with the given children.
+func Output(children ...Node) Node {
+	return El("output", children...)
+}
+
+// P returns a p element with the given children.
+func P(children ...Node) Node {
+	return El("p", children...)
+}
+
+// Param returns a param element. It's a void element, so only attribute children are rendered.
+func Param(children ...Node) Node {
+	return El("param", children...)
+}
+
+// Picture returns a picture element with the given children.
+func Picture(children ...Node) Node {
+	return El("picture", children...)
+}
+
+// Pre returns a pre element with the given children.
+func Pre(children ...Node) Node {
+	return El("pre", children...)
+}
+
+// Progress returns a progress element with the given children.
+func Progress(children ...Node) Node {
+	return El("progress", children...)
+}
+
+// Q returns a q element with the given children.
+func Q(children ...Node) Node {
+	return El("q", children...)
+}
+
+// Rp returns a rp element with the given children.
+func Rp(children ...Node) Node {
+	return El("rp", children...)
+}
+
+// Rt returns a rt element with the given children.
+func Rt(children ...Node) Node {
+	return El("rt", children...)
+}
+
+// Ruby returns a ruby element with the given children.
+func Ruby(children ...Node) Node {
+	return El("ruby", children...)
+}
+
+// S returns a s element with the given children.
+func S(children ...Node) Node {
+	return El("s", children...)
+}
+
+// Samp returns a samp element with the given children.
+func Samp(children ...Node) Node {
+	return El("samp", children...)
+}
+
+// Script returns a script element with the given children.
+func Script(children ...Node) Node {
+	return El("script", children...)
+}
+
+// Search returns a search element with the given children.
+func Search(children ...Node) Node {
+	return El("search", children...)
+}
+
+// Section returns a section element with the given children.
+func Section(children ...Node) Node {
+	return El("section", children...)
+}
+
+// Select returns a select element with the given children.
+func Select(children ...Node) Node {
+	return El("select", children...)
+}
+
+// SlotEl returns a slot element with the given children.
+func SlotEl(children ...Node) Node {
+	return El("slot", children...)
+}
+
+// Small returns a small element with the given children.
+func Small(children ...Node) Node {
+	return El("small", children...)
+}
+
+// Source returns a source element. It's a void element, so only attribute children are rendered.
+func Source(children ...Node) Node {
+	return El("source", children...)
+}
+
+// Span returns a span element with the given children.
+func Span(children ...Node) Node {
+	return El("span", children...)
+}
+
+// Strong returns a strong element with the given children.
+func Strong(children ...Node) Node {
+	return El("strong", children...)
+}
+
+// StyleEl returns a style element with the given children.
+func StyleEl(children ...Node) Node {
+	return El("style", children...)
+}
+
+// Sub returns a sub element with the given children.
+func Sub(children ...Node) Node {
+	return El("sub", children...)
+}
+
+// Summary returns a summary element with the given children.
+func Summary(children ...Node) Node {
+	return El("summary", children...)
+}
+
+// Sup returns a sup element with the given children.
+func Sup(children ...Node) Node {
+	return El("sup", children...)
+}
+
+// SVG returns a svg element with the given children.
+func SVG(children ...Node) Node {
+	return El("svg", children...)
+}
+
+// Table returns a table element with the given children.
+func Table(children ...Node) Node {
+	return El("table", children...)
+}
+
+// TBody returns a tbody element with the given children.
+func TBody(children ...Node) Node {
+	return El("tbody", children...)
+}
+
+// Td returns a td element with the given children.
+func Td(children ...Node) Node {
+	return El("td", children...)
+}
+
+// TemplateEl returns a template element with the given children.
+func TemplateEl(children ...Node) Node {
+	return El("template", children...)
+}
+
+// Textarea returns a textarea element with the given children.
+func Textarea(children ...Node) Node {
+	return El("textarea", children...)
+}
+
+// TFoot returns a tfoot element with the given children.
+func TFoot(children ...Node) Node {
+	return El("tfoot", children...)
+}
+
+// Th returns a th element with the given children.
+func Th(children ...Node) Node {
+	return El("th", children...)
+}
+
+// THead returns a thead element with the given children.
+func THead(children ...Node) Node {
+	return El("thead", children...)
+}
+
+// Time returns a time element with the given children.
+func Time(children ...Node) Node {
+	return El("time", children...)
+}
+
+// TitleEl returns a title element with the given children.
+func TitleEl(children ...Node) Node {
+	return El("title", children...)
+}
+
+// Tr returns a tr element with the given children.
+func Tr(children ...Node) Node {
+	return El("tr", children...)
+}
+
+// Track returns a track element. It's a void element, so only attribute children are rendered.
+func Track(children ...Node) Node {
+	return El("track", children...)
+}
+
+// U returns a u element with the given children.
+func U(children ...Node) Node {
+	return El("u", children...)
+}
+
+// Ul returns a ul element with the given children.
+func Ul(children ...Node) Node {
+	return El("ul", children...)
+}
+
+// Var returns a var element with the given children.
+func Var(children ...Node) Node {
+	return El("var", children...)
+}
+
+// Video returns a video element with the given children.
+func Video(children ...Node) Node {
+	return El("video", children...)
+}
+
+// Wbr returns a wbr element. It's a void element, so only attribute children are rendered.
+func Wbr(children ...Node) Node {
+	return El("wbr", children...)
+}
@@ -4,25 +4,25 @@
 package main
 
 import (
+	"context"
 	"net/http"
 
 	. "github.com/melias122/html"
+	ghttp "github.com/melias122/html/http"
 )
 
 func main() {
-	_ = http.ListenAndServe("localhost:8080", http.HandlerFunc(handler))
+	_ = http.ListenAndServe("localhost:8080", ghttp.Adapt(handler))
 }
 
-func handler(w http.ResponseWriter, r *http.Request) {
-	_ = Page(props{
+func handler(w http.ResponseWriter, r *http.Request) (Node, error) {
+	return Page(props{
 		title: r.URL.Path,
-		path:  r.URL.Path,
-	}).Render(w)
+	}), nil
 }
 
 type props struct {
 	title string
-	path  string
 }
 
 // Page is a whole document to output.
@@ -39,12 +39,14 @@ func Page(p props) Node {
 			),
 		},
 		Body: []Node{
-			Navbar(p.path, []PageLink{
+			Navbar([]PageLink{
 				{Path: "/foo", Name: "Foo"},
 				{Path: "/bar", Name: "Bar"},
 			}),
 			H1(Text(p.title)),
-			P(Textf("Welcome to the page at %v.", p.path)),
+			P(FromContext(func(ctx context.Context) Node {
+				return Textf("Welcome to the page at %v.", currentPath(ctx))
+			})),
 		},
 	})
 }
@@ -54,13 +56,13 @@ type PageLink struct {
 	Name string
 }
 
-func Navbar(currentPath string, links []PageLink) Node {
+func Navbar(links []PageLink) Node {
 	return Div(
 		Ul(
-			NavbarLink("/", "Home", currentPath),
+			NavbarLink("/", "Home"),
 
 			Group(Map(links, func(pl PageLink) Node {
-				return NavbarLink(pl.Path, pl.Name, currentPath)
+				return NavbarLink(pl.Path, pl.Name)
 			})),
 		),
 
@@ -68,6 +70,18 @@ func Navbar(currentPath string, links []PageLink) Node {
 	)
 }
 
-func NavbarLink(href, name, currentPath string) Node {
-	return Li(A(Href(href), Classes{"is-active": currentPath == href}, Text(name)))
+// NavbarLink reads the current path from the render context, so it doesn't have to be passed down.
+func NavbarLink(href, name string) Node {
+	return FromContext(func(ctx context.Context) Node {
+		return Li(A(Href(href), Classes{"is-active": currentPath(ctx) == href}, Text(name)))
+	})
+}
+
+// currentPath of the request, or "/" when rendering outside of a Handler adapted with Adapt.
+func currentPath(ctx context.Context) string {
+	r := ghttp.RequestFromContext(ctx)
+	if r == nil {
+		return "/"
+	}
+	return r.URL.Path
 }
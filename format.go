@@ -0,0 +1,260 @@
+package html
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/melias122/html/internal/elements"
+	"github.com/melias122/html/internal/token"
+)
+
+// Indent renders the Node n as indented HTML, for golden files, debugging, and view-source.
+// Block elements go on their own lines, indented by indent once per level of nesting.
+// Inline elements (like the ones created by Strong, Em, and Span) and text stay on the line of the block
+// they are in, and the content of Pre, Textarea, Script, and StyleEl elements is kept as is.
+// Whitespace is only added and removed around block elements.
+//
+// The whole Node is rendered before formatting, so Flush has no effect inside it.
+func Indent(n Node, indent string) Node {
+	return &formatter{n: n, format: func(w *strings.Builder, root *tokenTree) {
+		i := indenter{w: w, indent: indent}
+		i.children(root, 0)
+	}}
+}
+
+// formatter renders a Node to a buffer, and then writes it formatted with the format function.
+type formatter struct {
+	n      Node
+	format func(w *strings.Builder, root *tokenTree)
+}
+
+// Render satisfies Node.
+func (f *formatter) Render(w io.Writer) error {
+	return f.RenderContext(context.Background(), w)
+}
+
+// RenderContext satisfies ContextRenderer.
+func (f *formatter) RenderContext(ctx context.Context, w io.Writer) error {
+	if f.n == nil {
+		return nil
+	}
+	var b strings.Builder
+	if err := RenderCtx(ctx, f.n, &b); err != nil {
+		return err
+	}
+
+	var formatted strings.Builder
+	f.format(&formatted, buildTokenTree(token.Tokenize(b.String())))
+	_, err := io.WriteString(w, formatted.String())
+	return err
+}
+
+// Type satisfies nodeTypeDescriber.
+func (f *formatter) Type() NodeType {
+	return ElementType
+}
+
+// String satisfies fmt.Stringer.
+func (f *formatter) String() string {
+	var b strings.Builder
+	_ = f.Render(&b)
+	return b.String()
+}
+
+// tokenTree is a tree of tokens, with start tags containing the tokens up to their end tag.
+type tokenTree struct {
+	token    token.Token
+	children []*tokenTree
+	parent   *tokenTree
+	// end tag token, if the element had one.
+	end *token.Token
+}
+
+// name of the element, or the empty string if the tree is not an element.
+func (t *tokenTree) name() string {
+	if t.token.Type != token.StartTag {
+		return ""
+	}
+	return t.token.Name()
+}
+
+// buildTokenTree from the given tokens, closing elements at their end tag. Elements that are never closed
+// end with their parent, and end tags that don't close anything are kept as leaves.
+func buildTokenTree(tokens []token.Token) *tokenTree {
+	root := &tokenTree{token: token.Token{Type: -1}}
+	current := root
+
+	for _, tok := range tokens {
+		t := &tokenTree{token: tok, parent: current}
+
+		switch tok.Type {
+		case token.StartTag:
+			current.children = append(current.children, t)
+			if !tok.SelfClosing && !isVoidElement(tok.Name()) {
+				current = t
+			}
+
+		case token.EndTag:
+			name := tok.Name()
+			open := current
+			for open != root && open.name() != name {
+				open = open.parent
+			}
+			if open == root {
+				current.children = append(current.children, t)
+				continue
+			}
+			end := tok
+			open.end = &end
+			current = open.parent
+
+		default:
+			current.children = append(current.children, t)
+		}
+	}
+
+	return root
+}
+
+// writeRaw writes the tree as it was read.
+func (t *tokenTree) writeRaw(w *strings.Builder) {
+	w.WriteString(t.token.Raw)
+	for _, c := range t.children {
+		c.writeRaw(w)
+	}
+	if t.end != nil {
+		w.WriteString(t.end.Raw)
+	}
+}
+
+// inlineElements are formatted on the line of the block they are in.
+// See https://html.spec.whatwg.org/multipage/dom.html#phrasing-content
+var inlineElements = map[string]struct{}{
+	"a":        {},
+	"abbr":     {},
+	"b":        {},
+	"bdi":      {},
+	"bdo":      {},
+	"br":       {},
+	"button":   {},
+	"cite":     {},
+	"code":     {},
+	"data":     {},
+	"del":      {},
+	"dfn":      {},
+	"em":       {},
+	"i":        {},
+	"img":      {},
+	"input":    {},
+	"ins":      {},
+	"kbd":      {},
+	"label":    {},
+	"mark":     {},
+	"meter":    {},
+	"output":   {},
+	"progress": {},
+	"q":        {},
+	"s":        {},
+	"samp":     {},
+	"select":   {},
+	"small":    {},
+	"span":     {},
+	"strong":   {},
+	"sub":      {},
+	"sup":      {},
+	"textarea": {},
+	"time":     {},
+	"u":        {},
+	"var":      {},
+	"wbr":      {},
+}
+
+func isInlineElement(name string) bool {
+	_, ok := inlineElements[name]
+	return ok
+}
+
+// isPreformattedElement returns whether the element has its content formatted as is,
+// because whitespace in it is significant.
+func isPreformattedElement(name string) bool {
+	return elements.PreservesWhitespace(name)
+}
+
+// indenter writes a tokenTree as indented HTML.
+type indenter struct {
+	w      *strings.Builder
+	indent string
+}
+
+// isBlock returns whether the tree should be formatted on its own lines.
+// That is doctypes, and elements that are not inline or contain blocks themselves.
+func (i *indenter) isBlock(t *tokenTree) bool {
+	switch t.token.Type {
+	case token.Doctype:
+		return true
+	case token.StartTag:
+		name := t.name()
+		if !isInlineElement(name) || isPreformattedElement(name) {
+			return name != "textarea"
+		}
+		return i.hasBlocks(t)
+	default:
+		return false
+	}
+}
+
+func (i *indenter) hasBlocks(t *tokenTree) bool {
+	if isPreformattedElement(t.name()) {
+		return false
+	}
+	for _, c := range t.children {
+		if i.isBlock(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// children of t, with blocks on their own lines, and inline content between them on a line each.
+func (i *indenter) children(t *tokenTree, depth int) {
+	var run strings.Builder
+	writeRun := func() {
+		if s := strings.TrimSpace(run.String()); s != "" {
+			i.line(depth, s)
+		}
+		run.Reset()
+	}
+
+	for _, c := range t.children {
+		if !i.isBlock(c) {
+			c.writeRaw(&run)
+			continue
+		}
+		writeRun()
+
+		if !i.hasBlocks(c) {
+			var b strings.Builder
+			c.writeRaw(&b)
+			i.line(depth, b.String())
+			continue
+		}
+
+		i.line(depth, c.token.Raw)
+		i.children(c, depth+1)
+		if c.end != nil {
+			i.line(depth, c.end.Raw)
+		}
+	}
+	writeRun()
+}
+
+func (i *indenter) line(depth int, s string) {
+	if i.w.Len() > 0 {
+		i.w.WriteByte('\n')
+	}
+	for j := 0; j < depth; j++ {
+		i.w.WriteString(i.indent)
+	}
+	i.w.WriteString(s)
+}
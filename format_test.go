@@ -0,0 +1,112 @@
+package html
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestIndent(t *testing.T) {
+	t.Run("indents block elements and keeps inline elements inline", func(t *testing.T) {
+		n := Indent(HTML5(HTML5Props{
+			Title:    "Hat",
+			Language: "en",
+			Body: []Node{
+				Nav(Ul(Li(A(Href("/"), Text("Home"))), Li(A(Href("/hats"), Text("Hats"))))),
+				Div(Class("content"),
+					H1(Text("Hats")),
+					P(Text("Party hats are "), Strong(Text("great")), Text(".")),
+					Hr(),
+				),
+			},
+		}), "  ")
+
+		Equal(t, `<!doctype html>
+<html lang="en">
+  <head>
+    <meta charset="utf-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <title>Hat</title>
+  </head>
+  <body>
+    <nav>
+      <ul>
+        <li><a href="/">Home</a></li>
+        <li><a href="/hats">Hats</a></li>
+      </ul>
+    </nav>
+    <div class="content">
+      <h1>Hats</h1>
+      <p>Party hats are <strong>great</strong>.</p>
+      <hr>
+    </div>
+  </body>
+</html>`, n)
+	})
+
+	t.Run("keeps whitespace in preformatted elements", func(t *testing.T) {
+		n := Indent(Div(
+			Pre(Text("  party\n    hat"), Div(Text(" hi "))),
+			P(Textarea(Text(" a\n b"))),
+			Script(Raw("if (a) {\n  b()\n}")),
+			StyleEl(Raw("p { color: red }")),
+		), "\t")
+
+		Equal(t, "<div>\n\t<pre>  party\n    hat<div> hi </div></pre>\n\t<p><textarea> a\n b</textarea></p>\n"+
+			"\t<script>if (a) {\n  b()\n}</script>\n\t<style>p { color: red }</style>\n</div>", n)
+	})
+
+	t.Run("puts inline content between blocks on its own line", func(t *testing.T) {
+		n := Indent(Div(Text("before "), Span(Text("hat")), Div(), Text(" after"), Raw("<!-- hat -->")), "  ")
+		Equal(t, "<div>\n  before <span>hat</span>\n  <div></div>\n  after<!-- hat -->\n</div>", n)
+	})
+
+	t.Run("formats inline elements containing blocks as blocks", func(t *testing.T) {
+		n := Indent(A(Href("/"), Div(Text("hat"))), "  ")
+		Equal(t, "<a href=\"/\">\n  <div>hat</div>\n</a>", n)
+	})
+
+	t.Run("handles unclosed elements and stray end tags in raw content", func(t *testing.T) {
+		n := Indent(Raw("<div><section><p>hat</div></span>"), "  ")
+		Equal(t, "<div>\n  <section>\n    <p>hat\n</div>\n</span>", n)
+	})
+
+	t.Run("passes the render context on", func(t *testing.T) {
+		n := Indent(Div(FromContext(func(ctx context.Context) Node {
+			return P(Text(ctx.Value(contextKey("hat")).(string)))
+		})), "  ")
+
+		var b strings.Builder
+		ctx := context.WithValue(context.Background(), contextKey("hat"), "partyhat")
+		if err := RenderCtx(ctx, n, &b); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != "<div>\n  <p>partyhat</p>\n</div>" {
+			t.Fatal("got", b.String())
+		}
+	})
+
+	t.Run("returns render errors", func(t *testing.T) {
+		n := Indent(Div(NodeFunc(func(io.Writer) error {
+			return errors.New("no hats")
+		})), "  ")
+		Error(t, n.Render(&strings.Builder{}))
+	})
+
+	t.Run("renders nothing for a nil node", func(t *testing.T) {
+		Equal(t, "", Indent(nil, "  "))
+	})
+}
+
+func ExampleIndent() {
+	e := Indent(Div(H1(Text("Hats")), P(Text("Party hats are "), Em(Text("great")))), "  ")
+	_ = e.Render(os.Stdout)
+	// Output:
+	// <div>
+	//   <h1>Hats</h1>
+	//   <p>Party hats are <em>great</em></p>
+	// </div>
+}
@@ -14,10 +14,15 @@
 package html
 
 import (
+	"context"
 	"fmt"
+	stdhtml "html"
 	"html/template"
 	"io"
 	"strings"
+
+	"github.com/melias122/html/internal/elements"
+	"github.com/melias122/html/internal/token"
 )
 
 // Node is a DOM node that can Render itself to a io.Writer.
@@ -61,59 +66,286 @@ func (n NodeFunc) String() string {
 	return b.String()
 }
 
+// ContextRenderer can be implemented by Nodes that want to know the context they are rendered in,
+// for example to stop rendering early when a request is cancelled or a deadline has passed.
+// El passes the context on to children implementing it. Use RenderCtx to start rendering with a context.
+type ContextRenderer interface {
+	RenderContext(ctx context.Context, w io.Writer) error
+}
+
+// ContextNodeFunc is a render function that receives the render context, and is also a Node of ElementType.
+type ContextNodeFunc func(context.Context, io.Writer) error
+
+// Render satisfies Node. The function is called with context.Background().
+func (n ContextNodeFunc) Render(w io.Writer) error {
+	return n(context.Background(), w)
+}
+
+// RenderContext satisfies ContextRenderer.
+func (n ContextNodeFunc) RenderContext(ctx context.Context, w io.Writer) error {
+	return n(ctx, w)
+}
+
+// Type satisfies nodeTypeDescriber.
+func (n ContextNodeFunc) Type() NodeType {
+	return ElementType
+}
+
+// String satisfies fmt.Stringer.
+func (n ContextNodeFunc) String() string {
+	var b strings.Builder
+	_ = n.Render(&b)
+	return b.String()
+}
+
+// RenderCtx renders the Node n to w with the given context.
+// If n implements ContextRenderer, the context is passed on, otherwise n is rendered with Render.
+// Elements created with El stop rendering between children and return ctx.Err() once the context is done.
+func RenderCtx(ctx context.Context, n Node, w io.Writer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if c, ok := n.(ContextRenderer); ok {
+		return c.RenderContext(ctx, w)
+	}
+	return n.Render(w)
+}
+
+// FromContext creates a Node from a function that is called with the render context.
+// Use it for components that need request-scoped values, such as the current user or locale,
+// without passing them down through every component. See also WithValue.
+// The returned Node may be nil or a Group, which is rendered child by child.
+// Nodes of AttributeType are not rendered, since FromContext is a Node of ElementType.
+func FromContext(fn func(ctx context.Context) Node) Node {
+	return ContextNodeFunc(func(ctx context.Context, w io.Writer) error {
+		sw := &statefulWriter{w: w}
+		renderChild(ctx, sw, fn(ctx), ElementType)
+		return sw.err
+	})
+}
+
+// WithValue renders the Node n with a render context where key is associated with val.
+// It works like context.WithValue, and the same rules for keys apply.
+// Like in FromContext, n may be nil or a Group, and Nodes of AttributeType are not rendered.
+func WithValue(key, val interface{}, n Node) Node {
+	return ContextNodeFunc(func(ctx context.Context, w io.Writer) error {
+		sw := &statefulWriter{w: w}
+		renderChild(context.WithValue(ctx, key, val), sw, n, ElementType)
+		return sw.err
+	})
+}
+
 // El creates an element DOM Node with a name and child Nodes.
 // See https://dev.w3.org/html5/spec-LC/syntax.html#elements-0 for how elements are rendered.
 // No tags are ever omitted from normal tags, even though it's allowed for elements given at
 // https://dev.w3.org/html5/spec-LC/syntax.html#optional-tags
+// Use Minify for output that omits them.
 // If an element is a void element, non-attribute children nodes are ignored.
+// The element honors the render context, see ContextRenderer.
+// The returned Node is an *Element, which can be inspected before rendering.
 // Use this if no convenience creator exists.
 func El(name string, children ...Node) Node {
-	return NodeFunc(func(w2 io.Writer) error {
-		w := &statefulWriter{w: w2}
+	return &Element{Name: name, Children: children}
+}
+
+// Element is an element DOM Node, as created by El.
+// Children holds both the attribute and element child Nodes, in the order they were given,
+// and may contain nil Nodes and Groups.
+type Element struct {
+	Name     string
+	Children []Node
+}
+
+// Render satisfies Node.
+func (e *Element) Render(w io.Writer) error {
+	return e.RenderContext(context.Background(), w)
+}
+
+// RenderContext satisfies ContextRenderer.
+func (e *Element) RenderContext(ctx context.Context, w2 io.Writer) error {
+	w := &statefulWriter{w: w2}
+
+	w.Write([]byte("<" + e.Name))
+
+	for _, c := range e.Children {
+		renderChild(ctx, w, c, AttributeType)
+	}
 
-		w.Write([]byte("<" + name))
+	w.Write([]byte(">"))
 
-		for _, c := range children {
-			renderChild(w, c, AttributeType)
+	if isVoidElement(e.Name) {
+		return w.err
+	}
+
+	for _, c := range e.Children {
+		renderChild(ctx, w, c, ElementType)
+	}
+
+	w.Write([]byte("</" + e.Name + ">"))
+	return w.err
+}
+
+// Type satisfies nodeTypeDescriber.
+func (e *Element) Type() NodeType {
+	return ElementType
+}
+
+// String satisfies fmt.Stringer.
+func (e *Element) String() string {
+	var b strings.Builder
+	_ = e.Render(&b)
+	return b.String()
+}
+
+// Attributes returns the child Nodes of AttributeType, with Groups flattened and nil Nodes left out.
+func (e *Element) Attributes() []Node {
+	return flatten(e.Children, AttributeType)
+}
+
+// ChildNodes returns the child Nodes of ElementType, with Groups flattened and nil Nodes left out.
+// Void elements have no child Nodes.
+func (e *Element) ChildNodes() []Node {
+	if isVoidElement(e.Name) {
+		return nil
+	}
+	return flatten(e.Children, ElementType)
+}
+
+// GetAttribute returns the value of the first attribute with the given name, compared case-insensitively,
+// and whether there is one. Attribute Nodes that are not an *Attribute, such as Classes, are rendered to find out
+// their names and values.
+func (e *Element) GetAttribute(name string) (string, bool) {
+	for _, a := range e.Attributes() {
+		for _, v := range attributeValues(a) {
+			if strings.EqualFold(v.Name, name) {
+				return v.Value, true
+			}
 		}
+	}
+	return "", false
+}
+
+// SetAttribute to the given value. The first attribute with the given name is replaced, and other ones with
+// the same name removed. If there is none, the attribute is added.
+// Children is replaced by a new slice, so Nodes sharing the old one are not changed.
+func (e *Element) SetAttribute(name, value string) {
+	a := &Attribute{Name: name, Value: value}
+	children, replaced := replaceAttribute(e.Children, name, a)
+	if !replaced {
+		children = append(children, a)
+	}
+	e.Children = children
+}
 
-		w.Write([]byte(">"))
+// RemoveAttribute removes all attributes with the given name.
+// Children is replaced by a new slice, so Nodes sharing the old one are not changed.
+func (e *Element) RemoveAttribute(name string) {
+	e.Children, _ = replaceAttribute(e.Children, name, nil)
+}
 
-		if isVoidElement(name) {
-			return w.err
+// replaceAttribute returns a copy of nodes, where the first attribute with the given name is replaced by a,
+// and the others removed, and whether one was replaced. Groups with such attributes are copied as well.
+func replaceAttribute(nodes []Node, name string, a Node) ([]Node, bool) {
+	result := make([]Node, 0, len(nodes)+1)
+	replaced := false
+	for _, n := range nodes {
+		if g, ok := n.(GroupNode); ok {
+			var groupReplaced bool
+			g, groupReplaced = replaceAttribute(g, name, a)
+			if groupReplaced {
+				replaced, a = true, nil
+			}
+			result = append(result, g)
+			continue
 		}
 
-		for _, c := range children {
-			renderChild(w, c, ElementType)
+		if n != nil && typeOf(n) == AttributeType && hasAttributeName(n, name) {
+			if a != nil {
+				result = append(result, a)
+				replaced, a = true, nil
+			}
+			continue
 		}
+		result = append(result, n)
+	}
+	return result, replaced
+}
 
-		w.Write([]byte("</" + name + ">"))
-		return w.err
-	})
+func hasAttributeName(n Node, name string) bool {
+	for _, v := range attributeValues(n) {
+		if strings.EqualFold(v.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// attributeValues returns the names and unescaped values of the attribute Node n.
+// Nodes that are not an *Attribute are rendered and parsed to find them.
+func attributeValues(n Node) []Attribute {
+	if a, ok := n.(*Attribute); ok {
+		return []Attribute{*a}
+	}
+
+	var b strings.Builder
+	b.WriteString("<x")
+	_ = n.Render(&b)
+	b.WriteString(">")
+	tokens := token.Tokenize(b.String())
+	if len(tokens) == 0 || tokens[0].Type != token.StartTag {
+		return nil
+	}
+
+	var values []Attribute
+	for _, a := range tokens[0].Attrs {
+		values = append(values, Attribute{Name: a.Name, Value: stdhtml.UnescapeString(a.Value), Boolean: !a.HasValue})
+	}
+	return values
+}
+
+// flatten returns the non-nil Nodes of type t, with Groups flattened.
+func flatten(nodes []Node, t NodeType) []Node {
+	var result []Node
+	for _, n := range nodes {
+		if n == nil {
+			continue
+		}
+		if g, ok := n.(GroupNode); ok {
+			result = append(result, flatten(g, t)...)
+			continue
+		}
+		if typeOf(n) == t {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// typeOf the Node n, which is ElementType unless n implements nodeTypeDescriber.
+func typeOf(n Node) NodeType {
+	if p, ok := n.(nodeTypeDescriber); ok {
+		return p.Type()
+	}
+	return ElementType
 }
 
 // renderChild c to the given writer w if the node type is t.
-func renderChild(w *statefulWriter, c Node, t NodeType) {
+// Children are rendered to the writer wrapped by w, so they can use its optional interfaces, see Flush.
+// Rendering is aborted with ctx.Err() if the context is done.
+func renderChild(ctx context.Context, w *statefulWriter, c Node, t NodeType) {
 	if w.err != nil || c == nil {
 		return
 	}
 
-	if g, ok := c.(group); ok {
-		for _, groupC := range g.children {
-			renderChild(w, groupC, t)
+	if g, ok := c.(GroupNode); ok {
+		for _, groupC := range g {
+			renderChild(ctx, w, groupC, t)
 		}
 		return
 	}
 
-	switch t {
-	case ElementType:
-		if p, ok := c.(nodeTypeDescriber); !ok || p.Type() == ElementType {
-			w.err = c.Render(w.w)
-		}
-	case AttributeType:
-		if p, ok := c.(nodeTypeDescriber); ok && p.Type() == AttributeType {
-			w.err = c.Render(w.w)
-		}
+	if typeOf(c) == t {
+		w.err = RenderCtx(ctx, c, w.w)
 	}
 }
 
@@ -130,118 +362,196 @@ func (w *statefulWriter) Write(p []byte) {
 	_, w.err = w.w.Write(p)
 }
 
-// voidElements don't have end tags and must be treated differently in the rendering.
-// See https://dev.w3.org/html5/spec-LC/syntax.html#void-elements
-var voidElements = map[string]struct{}{
-	"area":    {},
-	"base":    {},
-	"br":      {},
-	"col":     {},
-	"command": {},
-	"embed":   {},
-	"hr":      {},
-	"img":     {},
-	"input":   {},
-	"keygen":  {},
-	"link":    {},
-	"meta":    {},
-	"param":   {},
-	"source":  {},
-	"track":   {},
-	"wbr":     {},
-}
-
 func isVoidElement(name string) bool {
-	_, ok := voidElements[name]
-	return ok
+	return elements.IsVoid(name)
 }
 
 // Attr creates an attribute DOM Node with a name and optional value.
 // If only a name is passed, it's a name-only (boolean) attribute (like "required").
 // If a name and value are passed, it's a name-value attribute (like `class="header"`).
 // More than one value make Attr panic.
+// The returned Node is an *Attribute, which can be inspected before rendering.
 // Use this if no convenience creator exists.
 func Attr(name string, value ...string) Node {
 	switch len(value) {
 	case 0:
-		return &attr{name: name}
+		return &Attribute{Name: name, Boolean: true}
 	case 1:
-		return &attr{name: name, value: &value[0]}
+		return &Attribute{Name: name, Value: value[0]}
 	default:
 		panic("attribute must be just name or name and value pair")
 	}
 }
 
-type attr struct {
-	name  string
-	value *string
+// Attribute is an attribute DOM Node, as created by Attr.
+// Boolean attributes are rendered by name only, and their Value is ignored.
+type Attribute struct {
+	Name    string
+	Value   string
+	Boolean bool
 }
 
 // Render satisfies Node.
-func (a *attr) Render(w io.Writer) error {
-	if a.value == nil {
-		_, err := w.Write([]byte(" " + a.name))
+func (a *Attribute) Render(w io.Writer) error {
+	if a.Boolean {
+		_, err := w.Write([]byte(" " + a.Name))
 		return err
 	}
-	_, err := w.Write([]byte(" " + a.name + `="` + template.HTMLEscapeString(*a.value) + `"`))
+	_, err := w.Write([]byte(" " + a.Name + `="` + template.HTMLEscapeString(a.Value) + `"`))
 	return err
 }
 
 // Type satisfies nodeTypeDescriber.
-func (a *attr) Type() NodeType {
+func (a *Attribute) Type() NodeType {
 	return AttributeType
 }
 
 // String satisfies fmt.Stringer.
-func (a *attr) String() string {
+func (a *Attribute) String() string {
 	var b strings.Builder
 	_ = a.Render(&b)
 	return b.String()
 }
 
 // Text creates a text DOM Node that Renders the escaped string t.
+// The returned Node is a TextNode.
 func Text(t string) Node {
-	return NodeFunc(func(w io.Writer) error {
-		_, err := w.Write([]byte(template.HTMLEscapeString(t)))
-		return err
-	})
+	return TextNode(t)
 }
 
 // Textf creates a text DOM Node that Renders the interpolated and escaped string t.
+// The string is interpolated when the Node is rendered, and the returned Node is a *TextfNode.
 func Textf(format string, a ...interface{}) Node {
-	return NodeFunc(func(w io.Writer) error {
-		_, err := w.Write([]byte(template.HTMLEscapeString(fmt.Sprintf(format, a...))))
-		return err
-	})
+	return &TextfNode{Format: format, Args: a}
+}
+
+// TextfNode is a text DOM Node, as created by Textf. It Renders the escaped string interpolated from
+// the format and arguments, like fmt.Sprintf.
+type TextfNode struct {
+	Format string
+	Args   []interface{}
+}
+
+// Render satisfies Node.
+func (t *TextfNode) Render(w io.Writer) error {
+	_, err := w.Write([]byte(template.HTMLEscapeString(fmt.Sprintf(t.Format, t.Args...))))
+	return err
+}
+
+// Type satisfies nodeTypeDescriber.
+func (t *TextfNode) Type() NodeType {
+	return ElementType
+}
+
+// String satisfies fmt.Stringer.
+func (t *TextfNode) String() string {
+	return template.HTMLEscapeString(fmt.Sprintf(t.Format, t.Args...))
+}
+
+// TextNode is a text DOM Node, as created by Text and Textf. It Renders the escaped string.
+type TextNode string
+
+// Render satisfies Node.
+func (t TextNode) Render(w io.Writer) error {
+	_, err := w.Write([]byte(template.HTMLEscapeString(string(t))))
+	return err
+}
+
+// Type satisfies nodeTypeDescriber.
+func (t TextNode) Type() NodeType {
+	return ElementType
+}
+
+// String satisfies fmt.Stringer.
+func (t TextNode) String() string {
+	return template.HTMLEscapeString(string(t))
 }
 
 // Raw creates a text DOM Node that just Renders the unescaped string t.
+// The returned Node is a RawNode.
 func Raw(t string) Node {
-	return NodeFunc(func(w io.Writer) error {
-		_, err := w.Write([]byte(t))
-		return err
-	})
+	return RawNode(t)
+}
+
+// RawNode is a text DOM Node, as created by Raw. It Renders the unescaped string.
+type RawNode string
+
+// Render satisfies Node.
+func (r RawNode) Render(w io.Writer) error {
+	_, err := w.Write([]byte(r))
+	return err
+}
+
+// Type satisfies nodeTypeDescriber.
+func (r RawNode) Type() NodeType {
+	return ElementType
 }
 
-type group struct {
-	children []Node
+// String satisfies fmt.Stringer.
+func (r RawNode) String() string {
+	return string(r)
 }
 
+// GroupNode is a group of Nodes, as created by Group.
+type GroupNode []Node
+
 // String satisfies fmt.Stringer.
-func (g group) String() string {
+func (g GroupNode) String() string {
 	panic("cannot render group directly")
 }
 
 // Render satisfies Node.
-func (g group) Render(io.Writer) error {
+func (g GroupNode) Render(io.Writer) error {
 	panic("cannot render group directly")
 }
 
 // Group multiple Nodes into one Node. Useful for concatenation of Nodes in variadic functions.
 // The resulting Node cannot Render directly, trying it will panic.
 // Render must happen through a parent element created with El or a helper.
+// The returned Node is a GroupNode.
 func Group(children []Node) Node {
-	return group{children: children}
+	return GroupNode(children)
+}
+
+// flusher is implemented by writers that can flush buffered data, such as http.ResponseWriter.
+type flusher interface {
+	Flush()
+}
+
+// errorFlusher is implemented by writers that can flush buffered data and fail doing so, such as bufio.Writer.
+type errorFlusher interface {
+	Flush() error
+}
+
+// Flush creates a Node that flushes everything rendered so far, if the writer supports it.
+// Writers support flushing if they have a "Flush()" method like http.Flusher,
+// or a "Flush() error" method like bufio.Writer. For other writers, Flush renders nothing.
+// Use it to send the beginning of a page to the browser before rendering slow parts.
+func Flush() Node {
+	return flush{}
+}
+
+type flush struct{}
+
+// Render satisfies Node.
+func (f flush) Render(w io.Writer) error {
+	switch v := w.(type) {
+	case errorFlusher:
+		return v.Flush()
+	case flusher:
+		v.Flush()
+	}
+	return nil
+}
+
+// Type satisfies nodeTypeDescriber.
+func (f flush) Type() NodeType {
+	return ElementType
+}
+
+// String satisfies fmt.Stringer.
+func (f flush) String() string {
+	return ""
 }
 
 // If condition is true, return the given Node. Otherwise, return nil.
@@ -1,9 +1,12 @@
 package html
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -40,6 +43,174 @@ func TestNodeFunc(t *testing.T) {
 	})
 }
 
+func TestContextNodeFunc(t *testing.T) {
+	t.Run("implements fmt.Stringer", func(t *testing.T) {
+		fn := ContextNodeFunc(func(ctx context.Context, w io.Writer) error {
+			_, _ = w.Write([]byte("hat"))
+			return nil
+		})
+		if fn.String() != "hat" {
+			t.FailNow()
+		}
+	})
+
+	t.Run("gets a background context when rendered without one", func(t *testing.T) {
+		fn := ContextNodeFunc(func(ctx context.Context, w io.Writer) error {
+			if ctx == nil {
+				t.FailNow()
+			}
+			return nil
+		})
+		_ = fn.Render(&strings.Builder{})
+	})
+}
+
+type contextKey string
+
+func TestRenderCtx(t *testing.T) {
+	t.Run("passes the context on to child nodes of elements", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), contextKey("hat"), "partyhat")
+		n := El("div", El("span", ContextNodeFunc(func(ctx context.Context, w io.Writer) error {
+			_, err := w.Write([]byte(ctx.Value(contextKey("hat")).(string)))
+			return err
+		})))
+
+		var b strings.Builder
+		if err := RenderCtx(ctx, n, &b); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != "<div><span>partyhat</span></div>" {
+			t.Fatal("got", b.String())
+		}
+	})
+
+	t.Run("renders nodes that are not context aware", func(t *testing.T) {
+		var b strings.Builder
+		if err := RenderCtx(context.Background(), El("div", outsider{}), &b); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != "<div>outsider</div>" {
+			t.Fatal("got", b.String())
+		}
+	})
+
+	t.Run("returns the context error if the context is already done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var b strings.Builder
+		err := RenderCtx(ctx, El("div"), &b)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatal("error is", err)
+		}
+		if b.String() != "" {
+			t.Fatal("got", b.String())
+		}
+	})
+
+	t.Run("stops rendering children once the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		n := El("ul",
+			El("li", Text("hat")),
+			NodeFunc(func(io.Writer) error {
+				cancel()
+				return nil
+			}),
+			El("li", Text("partyhat")),
+		)
+
+		var b strings.Builder
+		err := RenderCtx(ctx, n, &b)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatal("error is", err)
+		}
+		if b.String() != "<ul><li>hat</li>" {
+			t.Fatal("got", b.String())
+		}
+	})
+
+	t.Run("returns the deadline error when the deadline has passed", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+
+		err := RenderCtx(ctx, Doctype(El("html")), &strings.Builder{})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatal("error is", err)
+		}
+	})
+}
+
+func ExampleRenderCtx() {
+	ctx := context.Background()
+	e := El("div", El("span"))
+	_ = RenderCtx(ctx, e, os.Stdout)
+	// Output: <div><span></span></div>
+}
+
+func TestFromContext(t *testing.T) {
+	t.Run("renders the node returned for the render context", func(t *testing.T) {
+		n := El("div", FromContext(func(ctx context.Context) Node {
+			return Text(ctx.Value(contextKey("hat")).(string))
+		}))
+
+		var b strings.Builder
+		ctx := context.WithValue(context.Background(), contextKey("hat"), "partyhat")
+		if err := RenderCtx(ctx, n, &b); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != "<div>partyhat</div>" {
+			t.Fatal("got", b.String())
+		}
+	})
+
+	t.Run("renders nothing if the function returns nil", func(t *testing.T) {
+		n := El("div", FromContext(func(ctx context.Context) Node {
+			return nil
+		}))
+		Equal(t, "<div></div>", n)
+	})
+
+	t.Run("renders the children of a returned group", func(t *testing.T) {
+		n := El("div", FromContext(func(ctx context.Context) Node {
+			return Group([]Node{El("span"), nil, Text("party"), Group([]Node{Text("hat")})})
+		}))
+		Equal(t, "<div><span></span>partyhat</div>", n)
+	})
+
+	t.Run("doesn't render returned attributes as content", func(t *testing.T) {
+		n := El("div", FromContext(func(ctx context.Context) Node {
+			return Group([]Node{Attr("id", "hat"), Text("party")})
+		}))
+		Equal(t, "<div>party</div>", n)
+	})
+}
+
+func TestWithValue(t *testing.T) {
+	t.Run("makes the value available to nodes below", func(t *testing.T) {
+		n := WithValue(contextKey("hat"), "partyhat", El("div", El("span", FromContext(func(ctx context.Context) Node {
+			return Text(ctx.Value(contextKey("hat")).(string))
+		}))))
+		Equal(t, "<div><span>partyhat</span></div>", n)
+	})
+
+	t.Run("renders the children of a group", func(t *testing.T) {
+		n := El("div", WithValue(contextKey("hat"), "partyhat", Group([]Node{El("span"), El("br")})))
+		Equal(t, "<div><span></span><br></div>", n)
+	})
+}
+
+func ExampleFromContext() {
+	type userKey struct{}
+
+	greeting := FromContext(func(ctx context.Context) Node {
+		return Textf("Hi, %v!", ctx.Value(userKey{}))
+	})
+
+	e := WithValue(userKey{}, "Partyhat", El("div", El("span", greeting)))
+	_ = e.Render(os.Stdout)
+	// Output: <div><span>Hi, Partyhat!</span></div>
+}
+
 func TestAttr(t *testing.T) {
 	t.Run("renders just the local name with one argument", func(t *testing.T) {
 		a := Attr("required")
@@ -76,6 +247,18 @@ func TestAttr(t *testing.T) {
 		a := Attr(`id`, `hat"><script`)
 		Equal(t, ` id="hat&#34;&gt;&lt;script"`, a)
 	})
+
+	t.Run("can be inspected", func(t *testing.T) {
+		a, ok := Attr("id", "hat").(*Attribute)
+		if !ok || a.Name != "id" || a.Value != "hat" || a.Boolean {
+			t.Fatal("attribute is", a)
+		}
+
+		a, ok = Attr("required").(*Attribute)
+		if !ok || a.Name != "required" || !a.Boolean {
+			t.Fatal("attribute is", a)
+		}
+	})
 }
 
 func BenchmarkAttr(b *testing.B) {
@@ -166,6 +349,96 @@ func TestEl(t *testing.T) {
 	})
 }
 
+func TestElement(t *testing.T) {
+	t.Run("can be inspected", func(t *testing.T) {
+		id := Attr("id", "hat")
+		span := El("span")
+		e, ok := El("div", id, nil, Group([]Node{span, Attr("hidden")})).(*Element)
+		if !ok {
+			t.Fatal("not an element")
+		}
+		if e.Name != "div" || len(e.Children) != 3 {
+			t.Fatal("element is", e.Name, e.Children)
+		}
+
+		attributes := e.Attributes()
+		if len(attributes) != 2 || attributes[0] != id || attributes[1].(*Attribute).Name != "hidden" {
+			t.Fatal("attributes are", attributes)
+		}
+
+		children := e.ChildNodes()
+		if len(children) != 1 || children[0] != span {
+			t.Fatal("child nodes are", children)
+		}
+	})
+
+	t.Run("has no child nodes if it's a void element", func(t *testing.T) {
+		e := El("br", El("span")).(*Element)
+		if len(e.ChildNodes()) != 0 {
+			t.FailNow()
+		}
+	})
+
+	t.Run("renders changes to its fields", func(t *testing.T) {
+		e := El("div", Attr("id", "hat")).(*Element)
+		e.Name = "span"
+		e.Children = append(e.Children, Text("party"))
+		Equal(t, `<span id="hat">party</span>`, e)
+	})
+
+	t.Run("implements fmt.Stringer", func(t *testing.T) {
+		e := El("div")
+		if fmt.Sprint(e) != "<div></div>" {
+			t.FailNow()
+		}
+	})
+}
+
+func TestElementAttributes(t *testing.T) {
+	t.Run("gets attribute values", func(t *testing.T) {
+		e := El("input", Attr("type", "text"), Classes{"hat": true}, Group([]Node{Attr("required"), Attr("value", "a&b")})).(*Element)
+
+		for name, expected := range map[string]string{"type": "text", "CLASS": "hat", "required": "", "value": "a&b"} {
+			if v, ok := e.GetAttribute(name); !ok || v != expected {
+				t.Fatal("value of", name, "is", v, ok)
+			}
+		}
+		if _, ok := e.GetAttribute("id"); ok {
+			t.FailNow()
+		}
+	})
+
+	t.Run("sets attributes in place and adds new ones last", func(t *testing.T) {
+		e := El("div", Classes{"hat": true}, Attr("id", "a"), Attr("class", "b"), Text("hat")).(*Element)
+		e.SetAttribute("class", "party")
+		e.SetAttribute("title", "hat")
+		Equal(t, `<div class="party" id="a" title="hat">hat</div>`, e)
+	})
+
+	t.Run("sets attributes in groups without changing the group", func(t *testing.T) {
+		g := Group([]Node{Attr("id", "a"), Attr("class", "b")})
+		e := El("div", g).(*Element)
+		e.SetAttribute("class", "c")
+		e.RemoveAttribute("id")
+		Equal(t, `<div class="c"></div>`, e)
+		Equal(t, `<div id="a" class="b"></div>`, El("div", g))
+	})
+
+	t.Run("removes attributes without changing other elements", func(t *testing.T) {
+		children := []Node{Attr("id", "a"), Attr("hidden")}
+		e := El("div", children...).(*Element)
+		e.RemoveAttribute("hidden")
+		Equal(t, `<div id="a"></div>`, e)
+		Equal(t, `<div id="a" hidden></div>`, El("div", children...))
+	})
+}
+
+func ExampleElement() {
+	e := El("a", Attr("href", "/hats"), Text("Hats")).(*Element)
+	fmt.Println(e.Name, e.Attributes()[0].(*Attribute).Value, e.ChildNodes()[0])
+	// Output: a /hats Hats
+}
+
 func BenchmarkEl(b *testing.B) {
 	b.Run("normal elements", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
@@ -188,6 +461,20 @@ func TestText(t *testing.T) {
 	})
 }
 
+func TestTextNode(t *testing.T) {
+	t.Run("can be inspected", func(t *testing.T) {
+		if n, ok := Text("<hat>").(TextNode); !ok || string(n) != "<hat>" {
+			t.Fatal("node is", n)
+		}
+	})
+
+	t.Run("implements fmt.Stringer", func(t *testing.T) {
+		if fmt.Sprint(Text("<hat>")) != "&lt;hat&gt;" {
+			t.FailNow()
+		}
+	})
+}
+
 func ExampleText() {
 	e := El("span", Text("Party hats > normal hats."))
 	_ = e.Render(os.Stdout)
@@ -199,6 +486,27 @@ func TestTextf(t *testing.T) {
 		e := Textf("<%v>", "div")
 		Equal(t, "&lt;div&gt;", e)
 	})
+
+	t.Run("interpolates when rendered", func(t *testing.T) {
+		count := 1
+		e := Textf("%v hats", &countStringer{&count})
+		count = 2
+		Equal(t, "2 hats", e)
+	})
+
+	t.Run("can be inspected", func(t *testing.T) {
+		if n, ok := Textf("%v hats", 2).(*TextfNode); !ok || n.Format != "%v hats" || len(n.Args) != 1 || n.Args[0] != 2 {
+			t.Fatal("node is", n)
+		}
+	})
+}
+
+type countStringer struct {
+	count *int
+}
+
+func (c *countStringer) String() string {
+	return strconv.Itoa(*c.count)
 }
 
 func ExampleTextf() {
@@ -214,6 +522,20 @@ func TestRaw(t *testing.T) {
 	})
 }
 
+func TestRawNode(t *testing.T) {
+	t.Run("can be inspected", func(t *testing.T) {
+		if n, ok := Raw("<hat>").(RawNode); !ok || string(n) != "<hat>" {
+			t.Fatal("node is", n)
+		}
+	})
+
+	t.Run("implements fmt.Stringer", func(t *testing.T) {
+		if fmt.Sprint(Raw("<hat>")) != "<hat>" {
+			t.FailNow()
+		}
+	})
+}
+
 func ExampleRaw() {
 	e := El("span",
 		Raw(`<button onclick="javascript:alert('Party time!')">Party hats</button> &gt; normal hats.`),
@@ -229,6 +551,14 @@ func TestGroup(t *testing.T) {
 		Equal(t, `<div class="foo"><img><br id="hat"><hr></div>`, e)
 	})
 
+	t.Run("can be inspected", func(t *testing.T) {
+		children := []Node{El("br"), El("hr")}
+		g, ok := Group(children).(GroupNode)
+		if !ok || len(g) != 2 || g[0] != children[0] || g[1] != children[1] {
+			t.Fatal("group is", g)
+		}
+	})
+
 	t.Run("panics on direct render", func(t *testing.T) {
 		e := Group(nil)
 		panicked := false
@@ -279,3 +609,52 @@ func ExampleIf() {
 	_ = e.Render(os.Stdout)
 	// Output: <div><span>You lost your hat!</span></div>
 }
+
+type flushingWriter struct {
+	strings.Builder
+	flushed []string
+}
+
+func (w *flushingWriter) Flush() {
+	w.flushed = append(w.flushed, w.String())
+}
+
+type erroringFlushWriter struct {
+	strings.Builder
+}
+
+func (w *erroringFlushWriter) Flush() error {
+	return errors.New("don't want to flush")
+}
+
+func TestFlush(t *testing.T) {
+	t.Run("flushes everything rendered so far", func(t *testing.T) {
+		n := El("html", El("head"), Flush(), El("body", El("div"), Flush(), El("span")))
+
+		var w flushingWriter
+		if err := n.Render(&w); err != nil {
+			t.Fatal(err)
+		}
+		if w.String() != "<html><head></head><body><div></div><span></span></body></html>" {
+			t.Fatal("got", w.String())
+		}
+		if len(w.flushed) != 2 || w.flushed[0] != "<html><head></head>" || w.flushed[1] != "<html><head></head><body><div></div>" {
+			t.Fatal("flushed", w.flushed)
+		}
+	})
+
+	t.Run("returns flush errors", func(t *testing.T) {
+		n := El("div", Flush(), El("span"))
+
+		var w erroringFlushWriter
+		Error(t, n.Render(&w))
+		if w.String() != "<div>" {
+			t.Fatal("got", w.String())
+		}
+	})
+
+	t.Run("renders nothing to writers that cannot flush", func(t *testing.T) {
+		n := El("div", Flush())
+		Equal(t, "<div></div>", n)
+	})
+}
@@ -0,0 +1,271 @@
+// Package htmltest provides test helpers that compare rendered HTML structurally.
+//
+// Rendered output is compared as a tree of elements, text, and comments, so changes that don't change
+// the document don't break tests: attribute order, the order of tokens in class attributes,
+// whitespace that isn't rendered, quoting, and how characters are escaped.
+// Runs of whitespace in text are collapsed to a single space, and whitespace is only ignored where it doesn't
+// render in a browser: at the start and end of block elements like p and div, and next to them.
+// So "<p>Hello <b>world</b></p>" and "<p>Hello<b>world</b></p>" are not equal.
+package htmltest
+
+import (
+	"fmt"
+	stdhtml "html"
+	"sort"
+	"strings"
+
+	"github.com/melias122/html"
+	"github.com/melias122/html/internal/diff"
+	"github.com/melias122/html/internal/elements"
+	"github.com/melias122/html/internal/token"
+)
+
+// T is the part of testing.TB used by the helpers in this package.
+type T interface {
+	Helper()
+	Fatalf(format string, args ...interface{})
+}
+
+// Equal checks that the rendered Node is structurally equal to the expected HTML,
+// and fails with a diff of the two trees otherwise.
+func Equal(t T, expected string, actual html.Node) {
+	t.Helper()
+
+	a := render(t, actual)
+	if diff := Diff(expected, a); diff != "" {
+		t.Fatalf("rendered HTML is not equal to expected (-expected +actual):\n%v", diff)
+	}
+}
+
+// AssertContains checks that the Node tree contains an element matching the CSS selector.
+// The selector is matched against the Node tree with html.Query.
+func AssertContains(t T, n html.Node, selector string) {
+	t.Helper()
+
+	if html.Query(n, selector) == nil {
+		t.Fatalf("no element matches %q in:\n%v", selector, Normalize(render(t, n)))
+	}
+}
+
+// AssertText checks that the first element matching the CSS selector has the given text content.
+// Whitespace in the text content and in want is collapsed and trimmed before comparing.
+func AssertText(t T, n html.Node, selector, want string) {
+	t.Helper()
+
+	e := html.Query(n, selector)
+	if e == nil {
+		t.Fatalf("no element matches %q in:\n%v", selector, Normalize(render(t, n)))
+		return
+	}
+
+	text := textContent(render(t, e))
+	want = strings.Join(strings.Fields(want), " ")
+	if text != want {
+		t.Fatalf("expected text of %q to be %q but got %q", selector, want, text)
+	}
+}
+
+// Diff returns a line diff of the normalized trees of the expected and actual HTML,
+// with removed lines prefixed by "-" and added lines by "+". It's empty if they are structurally equal.
+func Diff(expected, actual string) string {
+	e := strings.Split(Normalize(expected), "\n")
+	a := strings.Split(Normalize(actual), "\n")
+	return diff.Lines(e, a)
+}
+
+// Normalize HTML into an indented tree with one node per line, which is the same for HTML that is structurally equal.
+// Tag and attribute names keep their case, so the case of SVG names like viewBox is checked.
+func Normalize(s string) string {
+	var b strings.Builder
+	writeChildren(&b, "", buildTree(token.Tokenize(s)), 0)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func render(t T, n html.Node) string {
+	t.Helper()
+
+	var b strings.Builder
+	if err := n.Render(&b); err != nil {
+		t.Fatalf("error rendering node: %v", err)
+	}
+	return b.String()
+}
+
+// textContent of HTML, with whitespace collapsed and trimmed.
+func textContent(s string) string {
+	var b strings.Builder
+	for _, t := range token.Tokenize(s) {
+		if t.Type == token.Text {
+			b.WriteString(stdhtml.UnescapeString(t.Data))
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// node in a normalized tree.
+type node struct {
+	tok      token.Token
+	children []*node
+}
+
+// buildTree of tokens. End tags close the nearest open element with the same name,
+// and end tags without an open element are ignored.
+func buildTree(tokens []token.Token) []*node {
+	root := &node{}
+	stack := []*node{root}
+	for _, t := range tokens {
+		parent := stack[len(stack)-1]
+		switch t.Type {
+		case token.StartTag:
+			n := &node{tok: t}
+			parent.children = append(parent.children, n)
+			if !elements.IsVoid(t.Name()) && !t.SelfClosing {
+				stack = append(stack, n)
+			}
+		case token.EndTag:
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].tok.Name() == t.Name() {
+					stack = stack[:i]
+					break
+				}
+			}
+		default:
+			parent.children = append(parent.children, &node{tok: t})
+		}
+	}
+	return root.children
+}
+
+// blockElements are rendered as blocks, so whitespace at their start and end and next to them isn't rendered.
+var blockElements = map[string]struct{}{
+	"address": {}, "article": {}, "aside": {}, "base": {}, "blockquote": {}, "body": {}, "caption": {},
+	"col": {}, "colgroup": {}, "dd": {}, "details": {}, "dialog": {}, "div": {}, "dl": {}, "dt": {},
+	"fieldset": {}, "figcaption": {}, "figure": {}, "footer": {}, "form": {},
+	"h1": {}, "h2": {}, "h3": {}, "h4": {}, "h5": {}, "h6": {}, "head": {}, "header": {}, "hgroup": {},
+	"hr": {}, "html": {}, "legend": {}, "li": {}, "link": {}, "main": {}, "menu": {}, "meta": {}, "nav": {},
+	"noscript": {}, "ol": {}, "optgroup": {}, "option": {}, "p": {}, "pre": {}, "script": {}, "section": {},
+	"style": {}, "summary": {}, "table": {}, "tbody": {}, "td": {}, "template": {}, "tfoot": {}, "th": {},
+	"thead": {}, "title": {}, "tr": {}, "ul": {},
+}
+
+// isBlock returns whether n is nil, which is the start or end of a parent, or a block element.
+// Comments and doctypes are like blocks too, since they are on their own lines in normalized trees.
+func isBlock(n *node) bool {
+	switch {
+	case n == nil, n.tok.Type == token.Comment, n.tok.Type == token.Doctype:
+		return true
+	case n.tok.Type == token.StartTag:
+		_, ok := blockElements[n.tok.Name()]
+		return ok
+	default:
+		return false
+	}
+}
+
+// writeChildren of the element with the given name, or the empty name for the top level, which is like a block.
+// Whitespace in text is collapsed, and trimmed at the start and end of block elements and next to them.
+func writeChildren(b *strings.Builder, parent string, children []*node, depth int) {
+	indent := strings.Repeat("  ", depth)
+	_, inlineParent := blockElements[parent]
+	inlineParent = !inlineParent && parent != ""
+
+	for i, c := range children {
+		if c.tok.Type != token.Text {
+			c.write(b, depth)
+			continue
+		}
+
+		var prev, next *node
+		if i > 0 {
+			prev = children[i-1]
+		}
+		if i < len(children)-1 {
+			next = children[i+1]
+		}
+
+		text := collapse(stdhtml.UnescapeString(c.tok.Data))
+		if isBlock(prev) && (prev != nil || !inlineParent) {
+			text = strings.TrimPrefix(text, " ")
+		}
+		if isBlock(next) && (next != nil || !inlineParent) {
+			text = strings.TrimSuffix(text, " ")
+		}
+		if text != "" {
+			fmt.Fprintf(b, "%v%q\n", indent, text)
+		}
+	}
+}
+
+func (n *node) write(b *strings.Builder, depth int) {
+	indent := strings.Repeat("  ", depth)
+	switch n.tok.Type {
+	case token.Comment:
+		fmt.Fprintf(b, "%v<!--%v-->\n", indent, trim(n.tok.Data))
+		return
+	case token.Doctype:
+		fmt.Fprintf(b, "%v<!doctype %v>\n", indent, strings.ToLower(trim(n.tok.Data)))
+		return
+	}
+
+	b.WriteString(indent + "<" + n.tok.Data)
+	for _, a := range normalizeAttrs(n.tok.Attrs) {
+		fmt.Fprintf(b, " %v=%q", a.Name, a.Value)
+	}
+	b.WriteString(">\n")
+
+	if elements.PreservesWhitespace(n.tok.Name()) {
+		for _, c := range n.children {
+			if c.tok.Type == token.Text {
+				fmt.Fprintf(b, "%v  %q\n", indent, stdhtml.UnescapeString(c.tok.Data))
+				continue
+			}
+			c.write(b, depth+1)
+		}
+		return
+	}
+	writeChildren(b, n.tok.Name(), n.children, depth+1)
+}
+
+// normalizeAttrs by decoding values, sorting class tokens, and sorting attributes by name.
+func normalizeAttrs(attrs []token.Attr) []token.Attr {
+	result := make([]token.Attr, len(attrs))
+	for i, a := range attrs {
+		value := stdhtml.UnescapeString(a.Value)
+		if a.Name == "class" {
+			classes := strings.Fields(value)
+			sort.Strings(classes)
+			value = strings.Join(classes, " ")
+		}
+		result[i] = token.Attr{Name: a.Name, Value: value, HasValue: true}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// collapse runs of whitespace to a single space.
+func collapse(s string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range s {
+		if r == ' ' || r == '\t' || r == '\n' || r == '\f' || r == '\r' {
+			space = true
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(r)
+	}
+	if space {
+		b.WriteByte(' ')
+	}
+	return b.String()
+}
+
+// trim and collapse runs of whitespace to a single space.
+func trim(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
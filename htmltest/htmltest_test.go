@@ -0,0 +1,179 @@
+package htmltest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/melias122/html"
+	"github.com/melias122/html/htmltest"
+)
+
+// fakeT records failures instead of failing the test.
+type fakeT struct {
+	failed  bool
+	message string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Fatalf(format string, args ...interface{}) {
+	t.failed = true
+	t.message = fmt.Sprintf(format, args...)
+}
+
+func TestEqual(t *testing.T) {
+	t.Run("ignores attribute order, class order, whitespace, quoting and escaping", func(t *testing.T) {
+		n := html.Div(html.Class("b a"), html.ID("hat"),
+			html.Input(html.Type("checkbox"), html.Disabled()),
+			html.P(html.Text(`Party "hat"`)),
+		)
+
+		ft := &fakeT{}
+		htmltest.Equal(ft, `<div id=hat class="a  b">
+	<input disabled type='checkbox'/>
+	<p>
+		Party &quot;hat&quot;
+	</p>
+</div>`, n)
+		if ft.failed {
+			t.Fatal(ft.message)
+		}
+	})
+
+	t.Run("keeps whitespace in pre elements", func(t *testing.T) {
+		ft := &fakeT{}
+		htmltest.Equal(ft, "<pre> hat</pre>", html.Pre(html.Text("hat")))
+		if !ft.failed {
+			t.FailNow()
+		}
+	})
+
+	t.Run("fails with a tree diff", func(t *testing.T) {
+		ft := &fakeT{}
+		htmltest.Equal(ft, `<ul><li class="a">hat</li><li>cap</li></ul>`,
+			html.Ul(html.Li(html.Class("a"), html.Text("hat")), html.Li(html.Text("party"))))
+		if !ft.failed {
+			t.FailNow()
+		}
+
+		expected := `rendered HTML is not equal to expected (-expected +actual):
+  <ul>
+    <li class="a">
+      "hat"
+    <li>
+-     "cap"
++     "party"
+`
+		if ft.message != expected {
+			t.Fatalf("expected %v but got %v", expected, ft.message)
+		}
+	})
+
+	t.Run("fails on different attribute values", func(t *testing.T) {
+		ft := &fakeT{}
+		htmltest.Equal(ft, `<a href="/hats"></a>`, html.A(html.Href("/caps")))
+		if !ft.failed || !strings.Contains(ft.message, `- <a href="/hats">`) || !strings.Contains(ft.message, `+ <a href="/caps">`) {
+			t.Fatal(ft.message)
+		}
+	})
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("is empty for structurally equal HTML", func(t *testing.T) {
+		if d := htmltest.Diff(`<!DOCTYPE html><br><!--  hat -->`, "<!doctype html>\n<br/>\n<!-- hat -->"); d != "" {
+			t.Fatal(d)
+		}
+	})
+
+	t.Run("is not empty for whitespace that is rendered", func(t *testing.T) {
+		tests := [][2]string{
+			{"<p>Hello <b>world</b></p>", "<p>Hello<b>world</b></p>"},
+			{"<b>a</b> <i>b</i>", "<b>a</b><i>b</i>"},
+			{"<p>a<b> b</b></p>", "<p>a<b>b</b></p>"},
+			{"<span>a </span>b", "<span>a</span>b"},
+		}
+		for _, test := range tests {
+			if d := htmltest.Diff(test[0], test[1]); d == "" {
+				t.Fatalf("%v and %v are equal", test[0], test[1])
+			}
+		}
+	})
+
+	t.Run("is empty for whitespace that is not rendered or collapsed", func(t *testing.T) {
+		tests := [][2]string{
+			{"<p>Hello <b>world</b></p>", "<p>\n  Hello\t\t<b>world</b>\n</p>"},
+			{"<ul><li>a</li><li>b</li></ul>", "<ul>\n  <li>a</li>\n  <li>b</li>\n</ul>"},
+			{"<div><p>a</p>b</div>", "<div> <p>a</p> b </div>"},
+		}
+		for _, test := range tests {
+			if d := htmltest.Diff(test[0], test[1]); d != "" {
+				t.Fatalf("%v and %v are not equal:\n%v", test[0], test[1], d)
+			}
+		}
+	})
+
+	t.Run("shows added and removed elements", func(t *testing.T) {
+		d := htmltest.Diff(`<div><span></span></div>`, `<div><p></p><span></span></div>`)
+		if d != "  <div>\n+   <p>\n    <span>\n" {
+			t.Fatal(d)
+		}
+	})
+}
+
+func TestAssertContains(t *testing.T) {
+	n := html.Nav(html.Ul(html.Li(html.A(html.Href("/"), html.Class("is-active")))))
+
+	t.Run("passes if an element matches", func(t *testing.T) {
+		ft := &fakeT{}
+		htmltest.AssertContains(ft, n, "nav li > a.is-active")
+		if ft.failed {
+			t.Fatal(ft.message)
+		}
+	})
+
+	t.Run("fails if no element matches", func(t *testing.T) {
+		ft := &fakeT{}
+		htmltest.AssertContains(ft, n, "nav > a")
+		if !ft.failed || !strings.HasPrefix(ft.message, `no element matches "nav > a" in:`) {
+			t.Fatal(ft.message)
+		}
+	})
+}
+
+func TestAssertText(t *testing.T) {
+	n := html.Div(html.H1(html.Text("Party "), html.Em(html.Text("hats")), html.Text(" &\n more")))
+
+	t.Run("passes if the text content matches", func(t *testing.T) {
+		ft := &fakeT{}
+		htmltest.AssertText(ft, n, "h1", "Party hats & more")
+		if ft.failed {
+			t.Fatal(ft.message)
+		}
+	})
+
+	t.Run("fails if the text content differs", func(t *testing.T) {
+		ft := &fakeT{}
+		htmltest.AssertText(ft, n, "h1", "Party hats")
+		if !ft.failed || ft.message != `expected text of "h1" to be "Party hats" but got "Party hats & more"` {
+			t.Fatal(ft.message)
+		}
+	})
+
+	t.Run("fails if no element matches", func(t *testing.T) {
+		ft := &fakeT{}
+		htmltest.AssertText(ft, n, "h2", "Party hats")
+		if !ft.failed {
+			t.FailNow()
+		}
+	})
+}
+
+func ExampleNormalize() {
+	fmt.Println(htmltest.Normalize(`<div class="b a" id=x><p>Party  hats</p><br></div>`))
+	// Output:
+	// <div class="a b" id="x">
+	//   <p>
+	//     "Party hats"
+	//   <br>
+}
@@ -2,7 +2,12 @@
 package http
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
 	"net/http"
+	"sync"
 
 	"github.com/melias122/html"
 )
@@ -15,28 +20,211 @@ type errorWithStatusCode interface {
 	StatusCode() int
 }
 
+type requestContextKey struct{}
+
+// RequestFromContext returns the request that is being rendered by a Handler adapted with Adapt,
+// or nil if there is none.
+// Use it together with html.FromContext to read request-scoped values deep in the Node tree.
+func RequestFromContext(ctx context.Context) *http.Request {
+	r, _ := ctx.Value(requestContextKey{}).(*http.Request)
+	return r
+}
+
+// Option configures Adapt.
+type Option func(*options)
+
+type options struct {
+	streaming bool
+}
+
+// Streaming makes Adapt render the Node directly to the http.ResponseWriter, instead of rendering it
+// to a buffer first. The response starts reaching the client while the Node is rendering,
+// so errors during rendering only result in an error response if nothing has been written yet.
+// Otherwise, rendering stops, and the client keeps what it got so far.
+func Streaming() Option {
+	return func(o *options) {
+		o.streaming = true
+	}
+}
+
 // Adapt a Handler to a http.Handlerfunc.
 // The returned Node is rendered to the ResponseWriter, in both normal and error cases.
 // If the Handler returns an error, and it implements a "StatusCode() int" method, that HTTP status code is sent
 // in the response header. Otherwise, the status code http.StatusInternalServerError (500) is used.
-func Adapt(h Handler) http.HandlerFunc {
+// The request context is passed on to the Node, see html.RenderCtx, and the request itself is available
+// from the render context through RequestFromContext.
+//
+// The Node is rendered to a buffer first, and only sent when rendering succeeds.
+// If rendering fails, an error response is sent instead, with the status code taken from the render error
+// the same way as for errors returned by the Handler. See Streaming to opt out of buffering.
+// If the ResponseWriter implements http.Flusher, html.Flush nodes send the buffer rendered so far,
+// after which render errors cannot change the response anymore, and only stop rendering.
+// In that case, html.Deferred nodes are also rendered out of order: their fallback is sent with the rest of the Node,
+// and the loaded Nodes are streamed by html.DeferredContent, such as at the end of the body in html.HTML5,
+// or after the rest of the Node, as they become ready. See html.Deferred.
+// The scripts that swap them in get the nonce from html.WithNonce, which middleware can add to the request context.
+func Adapt(h Handler, opts ...Option) http.HandlerFunc {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	return func(w http.ResponseWriter, r *http.Request) {
 		n, err := h(w, r)
+		status := http.StatusOK
 		if err != nil {
-			switch v := err.(type) {
-			case errorWithStatusCode:
-				w.WriteHeader(v.StatusCode())
-			default:
-				w.WriteHeader(http.StatusInternalServerError)
-			}
+			status = statusCode(err)
 		}
 
 		if n == nil {
+			if err != nil {
+				w.WriteHeader(status)
+			}
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), requestContextKey{}, r)
+
+		// Deferred nodes are only rendered out of order if they can be flushed to the client.
+		var deferrals *html.Deferrals
+		if _, ok := w.(http.Flusher); ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(ctx)
+			defer cancel()
+			ctx, deferrals = html.WithDeferred(ctx)
+		}
+
+		if o.streaming {
+			if err != nil {
+				w.WriteHeader(status)
+			}
+			sw := &streamWriter{w: w, committed: err != nil}
+			if err := html.RenderCtx(ctx, n, sw); err != nil {
+				// Once the response has been committed, the client keeps what it got so far.
+				if !sw.committed {
+					http.Error(w, "error rendering node: "+err.Error(), statusCode(err))
+				}
+				return
+			}
+			renderDeferrals(ctx, deferrals, sw)
 			return
 		}
 
-		if err := n.Render(w); err != nil {
-			http.Error(w, "error rendering node: "+err.Error(), http.StatusInternalServerError)
+		b := &responseBuffer{w: w, b: getBuffer(), status: status}
+		defer putBuffer(b.b)
+
+		if err := html.RenderCtx(ctx, n, b); err != nil {
+			// If the response has been committed by a flush, it can't change anymore,
+			// so the rest of the buffer is dropped and the client keeps what it got so far.
+			if !b.committed {
+				http.Error(w, "error rendering node: "+err.Error(), statusCode(err))
+			}
+			return
 		}
+
+		renderDeferrals(ctx, deferrals, b)
+		_ = b.commit()
+	}
+}
+
+// renderDeferrals after the rest of the response, if there are any.
+// The response has been sent at this point, so errors for single html.Deferred nodes just leave their fallback
+// in place, and other errors mean the client is gone.
+func renderDeferrals(ctx context.Context, ds *html.Deferrals, w io.Writer) {
+	if ds == nil {
+		return
+	}
+	_ = ds.Render(ctx, w)
+}
+
+// responseBuffer buffers writes to a http.ResponseWriter until it is flushed.
+// On the first flush, the status code is written, and the response is committed.
+type responseBuffer struct {
+	w         http.ResponseWriter
+	b         *bytes.Buffer
+	status    int
+	committed bool
+}
+
+func (r *responseBuffer) Write(p []byte) (int, error) {
+	return r.b.Write(p)
+}
+
+// Flush the buffer to the ResponseWriter, if the ResponseWriter implements http.Flusher.
+// Otherwise, keep buffering, so render errors can still result in an error response.
+func (r *responseBuffer) Flush() error {
+	f, ok := r.w.(http.Flusher)
+	if !ok {
+		return nil
+	}
+	if err := r.commit(); err != nil {
+		return err
+	}
+	f.Flush()
+	return nil
+}
+
+// commit the status code, if not done already, and write the buffer to the ResponseWriter.
+func (r *responseBuffer) commit() error {
+	if !r.committed {
+		r.committed = true
+		if r.status != http.StatusOK {
+			r.w.WriteHeader(r.status)
+		}
+	}
+	_, err := r.b.WriteTo(r.w)
+	return err
+}
+
+// streamWriter writes directly to a http.ResponseWriter, and records whether the response has been committed.
+type streamWriter struct {
+	w         http.ResponseWriter
+	committed bool
+}
+
+func (s *streamWriter) Write(p []byte) (int, error) {
+	if len(p) > 0 {
+		s.committed = true
+	}
+	return s.w.Write(p)
+}
+
+// Flush the ResponseWriter, if it implements http.Flusher.
+func (s *streamWriter) Flush() {
+	if f, ok := s.w.(http.Flusher); ok {
+		s.committed = true
+		f.Flush()
+	}
+}
+
+// statusCode from the given error if it implements errorWithStatusCode, or http.StatusInternalServerError.
+func statusCode(err error) int {
+	var v errorWithStatusCode
+	if errors.As(err, &v) {
+		return v.StatusCode()
+	}
+	return http.StatusInternalServerError
+}
+
+// maxPooledBufferSize is the largest buffer capacity that is returned to the pool,
+// so a single huge page doesn't keep its memory around forever.
+const maxPooledBufferSize = 1 << 20
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+func getBuffer() *bytes.Buffer {
+	b := bufferPool.Get().(*bytes.Buffer)
+	b.Reset()
+	return b
+}
+
+func putBuffer(b *bytes.Buffer) {
+	if b.Cap() > maxPooledBufferSize {
+		return
 	}
+	bufferPool.Put(b)
 }
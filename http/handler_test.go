@@ -1,10 +1,13 @@
 package http
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/melias122/html"
@@ -50,6 +53,73 @@ func TestAdapt(t *testing.T) {
 		}
 	})
 
+	t.Run("does not send partially rendered nodes on render error", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("div", html.El("span"), erroringNode{}), nil
+		})
+		code, body := get(t, h)
+		if code != http.StatusInternalServerError {
+			t.Fatal("status code is", code)
+		}
+		if body != "error rendering node: don't want to\n" {
+			t.Fatal(`body is`, body)
+		}
+	})
+
+	t.Run("errors with status code if render error implements StatusCode method", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("div", html.NodeFunc(func(io.Writer) error {
+				return fmt.Errorf("wrapped: %w", statusCodeError{http.StatusNotFound})
+			})), nil
+		})
+		code, body := get(t, h)
+		if code != http.StatusNotFound {
+			t.Fatal("status code is", code)
+		}
+		if body != "error rendering node: wrapped: Not Found\n" {
+			t.Fatal(`body is`, body)
+		}
+	})
+
+	t.Run("renders directly to the response writer when streaming", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("div", html.El("span"), erroringNode{}), nil
+		}, Streaming())
+		code, body := get(t, h)
+		if code != http.StatusOK {
+			t.Fatal("status code is", code)
+		}
+		if body != "<div><span></span>" {
+			t.Fatal(`body is`, body)
+		}
+	})
+
+	t.Run("errors with status code if nothing has been written when streaming", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return erroringNode{}, nil
+		}, Streaming())
+		code, body := get(t, h)
+		if code != http.StatusInternalServerError {
+			t.Fatal("status code is", code)
+		}
+		if body != "error rendering node: don't want to\n" {
+			t.Fatal(`body is`, body)
+		}
+	})
+
+	t.Run("errors with status code and renders node when streaming", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("div"), statusCodeError{http.StatusTeapot}
+		}, Streaming())
+		code, body := get(t, h)
+		if code != http.StatusTeapot {
+			t.Fatal("status code is", code)
+		}
+		if body != "<div></div>" {
+			t.Fatal(`body is`, body)
+		}
+	})
+
 	t.Run("errors with status code if error implements StatusCode method and renders node", func(t *testing.T) {
 		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
 			return html.El("div"), statusCodeError{http.StatusTeapot}
@@ -75,8 +145,205 @@ func TestAdapt(t *testing.T) {
 			t.Fatal(`body is`, body)
 		}
 	})
+
+	t.Run("passes the request context on to the node", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("div", html.FromContext(func(ctx context.Context) html.Node {
+				return html.Text(ctx.Value(contextKey("hat")).(string))
+			})), nil
+		})
+
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request = request.WithContext(context.WithValue(request.Context(), contextKey("hat"), "partyhat"))
+		h.ServeHTTP(recorder, request)
+		if recorder.Code != http.StatusOK {
+			t.Fatal("status code is", recorder.Code)
+		}
+		if body := recorder.Body.String(); body != "<div>partyhat</div>" {
+			t.Fatal(`body is`, body)
+		}
+	})
+
+	t.Run("makes the request available to the node", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("div", html.FromContext(func(ctx context.Context) html.Node {
+				return html.Text(RequestFromContext(ctx).URL.Path)
+			})), nil
+		})
+		code, body := get(t, h)
+		if code != http.StatusOK {
+			t.Fatal("status code is", code)
+		}
+		if body != "<div>/</div>" {
+			t.Fatal(`body is`, body)
+		}
+	})
 }
 
+func TestAdaptFlush(t *testing.T) {
+	t.Run("sends the buffer rendered so far on flush", func(t *testing.T) {
+		var flushedBody string
+		var recorder *httptest.ResponseRecorder
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("html", html.El("head"), html.Flush(), html.El("body", html.NodeFunc(func(io.Writer) error {
+				flushedBody = recorder.Body.String()
+				return nil
+			}))), nil
+		})
+
+		recorder = httptest.NewRecorder()
+		h.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+		if !recorder.Flushed {
+			t.Fatal("not flushed")
+		}
+		if flushedBody != "<html><head></head>" {
+			t.Fatal("flushed body is", flushedBody)
+		}
+		if body := recorder.Body.String(); body != "<html><head></head><body></body></html>" {
+			t.Fatal("body is", body)
+		}
+	})
+
+	t.Run("sends the error status code from the handler on flush", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("div", html.Flush()), statusCodeError{http.StatusTeapot}
+		})
+		code, body := get(t, h)
+		if code != http.StatusTeapot {
+			t.Fatal("status code is", code)
+		}
+		if body != "<div></div>" {
+			t.Fatal("body is", body)
+		}
+	})
+
+	t.Run("cannot change the status code after flush", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("div", html.Flush(), erroringNode{}), nil
+		})
+		code, body := get(t, h)
+		if code != http.StatusOK {
+			t.Fatal("status code is", code)
+		}
+		if body != "<div>" {
+			t.Fatal("body is", body)
+		}
+	})
+
+	t.Run("keeps buffering if the response writer cannot flush", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("div", html.Flush(), erroringNode{}), nil
+		})
+		recorder := httptest.NewRecorder()
+		h.ServeHTTP(struct{ http.ResponseWriter }{recorder}, httptest.NewRequest(http.MethodGet, "/", nil))
+		if recorder.Code != http.StatusInternalServerError {
+			t.Fatal("status code is", recorder.Code)
+		}
+		if body := recorder.Body.String(); body != "error rendering node: don't want to\n" {
+			t.Fatal("body is", body)
+		}
+	})
+
+	t.Run("flushes directly when streaming", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("div", html.Flush()), nil
+		}, Streaming())
+		recorder := httptest.NewRecorder()
+		h.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+		if !recorder.Flushed {
+			t.Fatal("not flushed")
+		}
+	})
+}
+
+func TestAdaptDeferred(t *testing.T) {
+	t.Run("streams deferred nodes after the rest of the node", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("div", html.Deferred(html.Text("Loading…"), func(ctx context.Context) (html.Node, error) {
+				if RequestFromContext(ctx) != r {
+					return nil, errors.New("no request")
+				}
+				return html.El("span"), nil
+			})), nil
+		})
+		code, body := get(t, h)
+		if code != http.StatusOK {
+			t.Fatal("status code is", code)
+		}
+		if !strings.HasPrefix(body, `<div><html-deferred id="html-deferred-0">Loading…</html-deferred></div>`+
+			`<template id="html-deferred-0-content"><span></span></template><script>`) {
+			t.Fatal("body is", body)
+		}
+	})
+
+	t.Run("streams deferred nodes at the end of the body with the nonce from the request context", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.HTML5(html.HTML5Props{Body: []html.Node{html.Deferred(html.Text("Loading…"), func(ctx context.Context) (html.Node, error) {
+				return html.El("span"), nil
+			})}}), nil
+		})
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		h.ServeHTTP(recorder, request.WithContext(html.WithNonce(request.Context(), "abc")))
+		body := recorder.Body.String()
+		if !strings.Contains(body, `</html-deferred><template id="html-deferred-0-content"><span></span></template><script nonce="abc">`) ||
+			!strings.HasSuffix(body, `</script></body></html>`) {
+			t.Fatal("body is", body)
+		}
+	})
+
+	t.Run("leaves the fallback in place if loading fails", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("div", html.Deferred(html.Text("Loading…"), func(ctx context.Context) (html.Node, error) {
+				return nil, errors.New("no hats")
+			})), nil
+		})
+		code, body := get(t, h)
+		if code != http.StatusOK {
+			t.Fatal("status code is", code)
+		}
+		if body != `<div><html-deferred id="html-deferred-0">Loading…</html-deferred></div>` {
+			t.Fatal("body is", body)
+		}
+	})
+
+	t.Run("renders deferred nodes in order if the response writer cannot flush", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("div", html.Deferred(html.Text("Loading…"), func(ctx context.Context) (html.Node, error) {
+				return html.El("span"), nil
+			})), nil
+		})
+		recorder := httptest.NewRecorder()
+		h.ServeHTTP(struct{ http.ResponseWriter }{recorder}, httptest.NewRequest(http.MethodGet, "/", nil))
+		if body := recorder.Body.String(); body != "<div><span></span></div>" {
+			t.Fatal("body is", body)
+		}
+	})
+
+	t.Run("streams deferred nodes when streaming", func(t *testing.T) {
+		h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			return html.El("div", html.Deferred(html.Text("Loading…"), func(ctx context.Context) (html.Node, error) {
+				return html.El("span"), nil
+			})), nil
+		}, Streaming())
+		_, body := get(t, h)
+		if !strings.Contains(body, `<template id="html-deferred-0-content"><span></span></template>`) {
+			t.Fatal("body is", body)
+		}
+	})
+}
+
+func TestRequestFromContext(t *testing.T) {
+	t.Run("returns nil if there is no request", func(t *testing.T) {
+		if RequestFromContext(context.Background()) != nil {
+			t.FailNow()
+		}
+	})
+}
+
+type contextKey string
+
 type erroringNode struct{}
 
 func (n erroringNode) Render(io.Writer) error {
@@ -95,6 +362,17 @@ func (e statusCodeError) StatusCode() int {
 	return e.code
 }
 
+func BenchmarkAdapt(b *testing.B) {
+	h := Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+		return html.El("div", html.El("span", html.Text("hat"))), nil
+	})
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	for i := 0; i < b.N; i++ {
+		h.ServeHTTP(httptest.NewRecorder(), request)
+	}
+}
+
 func get(t *testing.T, h http.Handler) (int, string) {
 	t.Helper()
 
@@ -0,0 +1,417 @@
+// Command generate writes element and attribute helpers, and their tests, from a spec data file.
+//
+// It's run with go generate in the package directory, like:
+//
+//	//go:generate go run ./internal/cmd/generate -spec internal/spec/html.json
+//
+// The spec is a JSON object with the package name, a list of elements, and a list of attributes.
+// Elements have a name, and are optionally void or obsolete. Obsolete elements are only in the list of void elements.
+// Attributes have a name, are optionally boolean, and are either global or used on a list of elements.
+//
+// Helper names are the element or attribute name with the first letter of each dash-separated word upper-cased.
+// If an element and an attribute get the same name, like the SVG clipPath element and clip-path attribute,
+// or the name is taken by a hand-written identifier in the package, the helpers get an El or Attr suffix,
+// like TitleEl and TitleAttr.
+// A helper name can be pinned with "go" in the spec, which is needed for names like BlockQuote and ID.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+type spec struct {
+	Package    string      `json:"package"`
+	Elements   []element   `json:"elements"`
+	Attributes []attribute `json:"attributes"`
+}
+
+type element struct {
+	Name     string `json:"name"`
+	Go       string `json:"go"`
+	Void     bool   `json:"void"`
+	Obsolete bool   `json:"obsolete"`
+}
+
+type attribute struct {
+	Name     string   `json:"name"`
+	Go       string   `json:"go"`
+	Boolean  bool     `json:"boolean"`
+	Global   bool     `json:"global"`
+	Elements []string `json:"elements"`
+}
+
+func main() {
+	specPath := flag.String("spec", "", "path to the spec `file`")
+	helpers := flag.String("helpers", "", "optional `file` to write html2go helper tables to")
+	names := flag.String("names", "", "optional `file` to write the parser's map of names with upper-case letters to")
+	void := flag.String("void", "", "optional `file` to write the shared set of void elements to")
+	flag.Parse()
+
+	if err := run(*specPath, ".", *helpers, *names, *void); err != nil {
+		fmt.Fprintln(os.Stderr, "generate:", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, dir, helpers, names, void string) error {
+	b, err := os.ReadFile(specPath)
+	if err != nil {
+		return err
+	}
+	var s spec
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("parsing %v: %w", specPath, err)
+	}
+
+	taken, err := takenIdents(dir)
+	if err != nil {
+		return err
+	}
+	if err := resolveNames(&s, taken); err != nil {
+		return fmt.Errorf("%v: %w", specPath, err)
+	}
+
+	files := map[string]*template.Template{
+		"elements_gen.go":        elementsTemplate,
+		"elements_gen_test.go":   elementsTestTemplate,
+		"attributes_gen.go":      attributesTemplate,
+		"attributes_gen_test.go": attributesTestTemplate,
+	}
+	for name, t := range files {
+		if err := write(filepath.Join(dir, name), t, s); err != nil {
+			return err
+		}
+	}
+	if helpers != "" {
+		if err := write(helpers, helpersTemplate, s); err != nil {
+			return err
+		}
+	}
+	if names != "" {
+		if err := write(names, namesTemplate, s); err != nil {
+			return err
+		}
+	}
+	if void != "" {
+		return write(void, voidTemplate, s)
+	}
+	return nil
+}
+
+// takenIdents are the exported top-level identifiers in the hand-written, non-test Go files in dir.
+func takenIdents(dir string) (map[string]bool, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), "_gen.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	taken := map[string]bool{}
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for name, obj := range f.Scope.Objects {
+				if ast.IsExported(name) && obj.Kind != ast.Bad {
+					taken[name] = true
+				}
+			}
+			for _, d := range f.Decls {
+				// Methods aren't in the file scope, but their names don't clash with functions anyway.
+				if fn, ok := d.(*ast.FuncDecl); ok && fn.Recv == nil && ast.IsExported(fn.Name.Name) {
+					taken[fn.Name.Name] = true
+				}
+			}
+		}
+	}
+	return taken, nil
+}
+
+// resolveNames sets the Go helper names that aren't pinned in the spec, and checks that they're unique.
+func resolveNames(s *spec, taken map[string]bool) error {
+	elements := map[string]bool{}
+	for _, e := range s.Elements {
+		if !e.Obsolete {
+			elements[goName(e.Name)] = true
+		}
+	}
+	attributes := map[string]bool{}
+	for _, a := range s.Attributes {
+		attributes[goName(a.Name)] = true
+	}
+
+	used := map[string]string{}
+	use := func(goName, what string) error {
+		if taken[goName] {
+			return fmt.Errorf("%v is named %v, which is taken by a hand-written identifier", what, goName)
+		}
+		if other, ok := used[goName]; ok {
+			return fmt.Errorf("%v and %v are both named %v", other, what, goName)
+		}
+		used[goName] = what
+		return nil
+	}
+
+	for i := range s.Elements {
+		e := &s.Elements[i]
+		if e.Obsolete {
+			continue
+		}
+		if e.Go == "" {
+			e.Go = goName(e.Name)
+			if attributes[e.Go] || taken[e.Go] {
+				e.Go += "El"
+			}
+		}
+		if err := use(e.Go, "element "+e.Name); err != nil {
+			return err
+		}
+	}
+
+	for i := range s.Attributes {
+		a := &s.Attributes[i]
+		if a.Go == "" {
+			a.Go = goName(a.Name)
+			if elements[a.Go] || taken[a.Go] {
+				a.Go += "Attr"
+			}
+		}
+		if err := use(a.Go, "attribute "+a.Name); err != nil {
+			return err
+		}
+		if !a.Global && len(a.Elements) == 0 {
+			return errors.New("attribute " + a.Name + " is neither global nor used on elements")
+		}
+	}
+	return nil
+}
+
+// goName upper-cases the first letter of each dash-separated word in name, and removes the dashes.
+func goName(name string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(name, "-") {
+		if word == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+func write(path string, t *template.Template, s spec) error {
+	var b bytes.Buffer
+	if err := t.Execute(&b, s); err != nil {
+		return err
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %v: %w\n%s", path, err, b.Bytes())
+	}
+	return os.WriteFile(path, src, 0644)
+}
+
+var funcs = template.FuncMap{
+	// q qualifies an identifier from the html package, outside of it.
+	"q": func(s spec, name string) string {
+		if s.Package == "html" {
+			return name
+		}
+		return "html." + name
+	},
+	"article": func(name string) string {
+		if strings.ContainsAny(name[:1], "aeio") {
+			return "an"
+		}
+		return "a"
+	},
+	// mixedCase names, by their lower-cased name.
+	"mixedCase": func(s spec) map[string]string {
+		names := map[string]string{}
+		for _, e := range s.Elements {
+			if strings.ToLower(e.Name) != e.Name {
+				names[strings.ToLower(e.Name)] = e.Name
+			}
+		}
+		for _, a := range s.Attributes {
+			if strings.ToLower(a.Name) != a.Name {
+				names[strings.ToLower(a.Name)] = a.Name
+			}
+		}
+		return names
+	},
+	"list": func(names []string) string {
+		switch len(names) {
+		case 1:
+			return names[0]
+		case 2:
+			return names[0] + " and " + names[1]
+		default:
+			return strings.Join(names[:len(names)-1], ", ") + ", and " + names[len(names)-1]
+		}
+	},
+}
+
+const header = `// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package {{.Package}}
+`
+
+var elementsTemplate = template.Must(template.New("").Funcs(funcs).Parse(header + `
+{{if ne .Package "html"}}import "github.com/melias122/html"{{end}}
+
+{{range .Elements}}{{if not .Obsolete}}
+{{if .Void}}// {{.Go}} returns {{article .Name}} {{.Name}} element. It's a void element, so only attribute children are rendered.
+{{- else}}// {{.Go}} returns {{article .Name}} {{.Name}} element with the given children.{{end}}
+func {{.Go}}(children ...{{q $ "Node"}}) {{q $ "Node"}} {
+	return {{q $ "El"}}("{{.Name}}", children...)
+}
+{{end}}{{end}}
+`))
+
+var attributesTemplate = template.Must(template.New("").Funcs(funcs).Parse(header + `
+{{if ne .Package "html"}}import "github.com/melias122/html"{{end}}
+
+{{range .Attributes}}
+// {{.Go}} returns {{if .Global}}a global{{else if .Boolean}}a{{else}}{{article .Name}}{{end}} {{if .Boolean}}boolean {{end}}{{.Name}} attribute
+{{- if not .Global}}, used on {{list .Elements}} elements{{end}}.
+{{if .Boolean}}func {{.Go}}() {{q $ "Node"}} {
+	return {{q $ "Attr"}}("{{.Name}}")
+}{{else}}func {{.Go}}(v string) {{q $ "Node"}} {
+	return {{q $ "Attr"}}("{{.Name}}", v)
+}{{end}}
+{{end}}
+
+{{if eq .Package "html"}}
+// booleanAttributes are rendered by name only, if their value is empty or the attribute name.
+// See https://html.spec.whatwg.org/multipage/indices.html#attributes-3
+var booleanAttributes = map[string]struct{}{
+{{range .Attributes}}{{if .Boolean}}"{{.Name}}": {},
+{{end}}{{end}}}
+{{end}}
+`))
+
+var elementsTestTemplate = template.Must(template.New("").Funcs(funcs).Parse(header + `
+import (
+	"fmt"
+	"testing"
+{{if ne .Package "html"}}
+	"github.com/melias122/html"
+{{end}})
+
+func TestSimpleElements(t *testing.T) {
+	cases := map[string]func(...{{q . "Node"}}) {{q . "Node"}}{
+{{range .Elements}}{{if not (or .Void .Obsolete)}}"{{.Name}}": {{.Go}},
+{{end}}{{end}}}
+
+	for name, fn := range cases {
+		t.Run(fmt.Sprintf("should output %v", name), func(t *testing.T) {
+			n := fn({{q . "Attr"}}("id", "hat"))
+			Equal(t, fmt.Sprintf(` + "`" + `<%v id="hat"></%v>` + "`" + `, name, name), n)
+		})
+	}
+}
+{{if eq .Package "html"}}
+func TestSimpleVoidKindElements(t *testing.T) {
+	cases := map[string]func(...Node) Node{
+{{range .Elements}}{{if and .Void (not .Obsolete)}}"{{.Name}}": {{.Go}},
+{{end}}{{end}}}
+
+	for name, fn := range cases {
+		t.Run(fmt.Sprintf("should output %v", name), func(t *testing.T) {
+			n := fn(Attr("id", "hat"), Text("ignored"))
+			Equal(t, fmt.Sprintf(` + "`" + `<%v id="hat">` + "`" + `, name), n)
+		})
+	}
+}
+{{end}}`))
+
+var attributesTestTemplate = template.Must(template.New("").Funcs(funcs).Parse(header + `
+import (
+	"fmt"
+	"testing"
+{{if ne .Package "html"}}
+	"github.com/melias122/html"
+{{end}})
+{{$boolean := false}}{{range .Attributes}}{{if .Boolean}}{{$boolean = true}}{{end}}{{end}}
+{{if $boolean}}
+func TestBooleanAttributes(t *testing.T) {
+	cases := map[string]func() {{q . "Node"}}{
+{{range .Attributes}}{{if .Boolean}}"{{.Name}}": {{.Go}},
+{{end}}{{end}}}
+
+	for name, fn := range cases {
+		t.Run(fmt.Sprintf("should output %v", name), func(t *testing.T) {
+			n := {{q . "El"}}("div", fn())
+			Equal(t, fmt.Sprintf(` + "`" + `<div %v></div>` + "`" + `, name), n)
+		})
+	}
+}
+{{end}}
+func TestSimpleAttributes(t *testing.T) {
+	cases := map[string]func(string) {{q . "Node"}}{
+{{range .Attributes}}{{if not .Boolean}}"{{.Name}}": {{.Go}},
+{{end}}{{end}}}
+
+	for name, fn := range cases {
+		t.Run(fmt.Sprintf(` + "`" + `should output %v="hat"` + "`" + `, name), func(t *testing.T) {
+			n := {{q . "El"}}("div", fn("hat"))
+			Equal(t, fmt.Sprintf(` + "`" + `<div %v="hat"></div>` + "`" + `, name), n)
+		})
+	}
+}
+`))
+
+var helpersTemplate = template.Must(template.New("").Funcs(funcs).Parse(`// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package main
+
+import "github.com/melias122/html"
+
+// elementHelpers create elements, and are used for elements with the same name.
+var elementHelpers = []func(...html.Node) html.Node{
+{{range .Elements}}{{if not .Obsolete}}html.{{.Go}},
+{{end}}{{end}}}
+
+// booleanAttributeHelpers create name-only attributes.
+var booleanAttributeHelpers = []func() html.Node{
+{{range .Attributes}}{{if .Boolean}}html.{{.Go}},
+{{end}}{{end}}}
+
+// attributeHelpers create attributes with a value.
+var attributeHelpers = []func(string) html.Node{
+{{range .Attributes}}{{if not .Boolean}}html.{{.Go}},
+{{end}}{{end}}}
+`))
+
+var voidTemplate = template.Must(template.New("").Funcs(funcs).Parse(`// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package elements
+
+// voidElements don't have end tags and must be treated differently in the rendering.
+// See https://html.spec.whatwg.org/multipage/syntax.html#void-elements
+var voidElements = map[string]struct{}{
+{{range .Elements}}{{if .Void}}"{{.Name}}": {},
+{{end}}{{end}}}
+`))
+
+var namesTemplate = template.Must(template.New("").Funcs(funcs).Parse(`// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package html
+
+// {{.Package}}Names are {{.Package}} element and attribute names with upper-case letters, by their lower-cased name.
+var {{.Package}}Names = map[string]string{
+{{range $lower, $name := mixedCase .}}"{{$lower}}": "{{$name}}",
+{{end}}}
+`))
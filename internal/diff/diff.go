@@ -0,0 +1,50 @@
+// Package diff compares lines of text.
+package diff
+
+import (
+	"strings"
+)
+
+// Lines returns a diff of a and b using their longest common subsequence, with removed lines prefixed by "- ",
+// added lines by "+ ", and unchanged lines by two spaces. It's empty if a and b are equal.
+func Lines(a, b []string) string {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var d strings.Builder
+	changed := false
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			d.WriteString("  " + a[i] + "\n")
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] > lcs[i+1][j]):
+			d.WriteString("+ " + b[j] + "\n")
+			changed = true
+			j++
+		default:
+			d.WriteString("- " + a[i] + "\n")
+			changed = true
+			i++
+		}
+	}
+	if !changed {
+		return ""
+	}
+	return d.String()
+}
@@ -0,0 +1,30 @@
+package diff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/melias122/html/internal/diff"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected string
+	}{
+		{"equal", "a\nb", "a\nb", ""},
+		{"added", "a\nc", "a\nb\nc", "  a\n+ b\n  c\n"},
+		{"removed", "a\nb\nc", "a\nc", "  a\n- b\n  c\n"},
+		{"changed", "a\nb", "a\nc", "  a\n- b\n+ c\n"},
+		{"empty", "", "a", "- \n+ a\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if d := diff.Lines(strings.Split(test.a, "\n"), strings.Split(test.b, "\n")); d != test.expected {
+				t.Fatalf("expected %q but got %q", test.expected, d)
+			}
+		})
+	}
+}
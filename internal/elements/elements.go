@@ -0,0 +1,26 @@
+// Package elements has the sets of HTML elements that rendering, parsing, formatting, and comparing HTML
+// treat specially, so they agree on them.
+package elements
+
+// IsVoid returns whether the element with the name is a void element, which has no end tag and no children.
+func IsVoid(name string) bool {
+	_, ok := voidElements[name]
+	return ok
+}
+
+// whitespacePreservingElements have text content where whitespace is significant.
+var whitespacePreservingElements = map[string]struct{}{
+	"listing":   {},
+	"plaintext": {},
+	"pre":       {},
+	"script":    {},
+	"style":     {},
+	"textarea":  {},
+	"xmp":       {},
+}
+
+// PreservesWhitespace returns whether the element with the name has text content where whitespace is significant.
+func PreservesWhitespace(name string) bool {
+	_, ok := whitespacePreservingElements[name]
+	return ok
+}
@@ -0,0 +1,21 @@
+package elements
+
+import (
+	"testing"
+)
+
+func TestIsVoid(t *testing.T) {
+	for name, expected := range map[string]bool{"br": true, "command": true, "keygen": true, "div": false, "BR": false} {
+		if IsVoid(name) != expected {
+			t.Errorf("expected IsVoid(%q) to be %v", name, expected)
+		}
+	}
+}
+
+func TestPreservesWhitespace(t *testing.T) {
+	for name, expected := range map[string]bool{"pre": true, "textarea": true, "xmp": true, "div": false} {
+		if PreservesWhitespace(name) != expected {
+			t.Errorf("expected PreservesWhitespace(%q) to be %v", name, expected)
+		}
+	}
+}
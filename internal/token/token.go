@@ -0,0 +1,276 @@
+// Package token splits HTML into tokens, following the tokenization rules of HTML 5 closely enough
+// for rendered gomponents and hand-written markup.
+// See https://html.spec.whatwg.org/multipage/parsing.html#tokenization for the full rules.
+package token
+
+import (
+	"strings"
+)
+
+// Type of a Token.
+type Type int
+
+const (
+	Text = Type(iota)
+	StartTag
+	EndTag
+	Comment
+	Doctype
+)
+
+// Attr is an attribute in a StartTag Token.
+// The Value is as written in the source, so character references are not decoded.
+type Attr struct {
+	Name     string
+	Value    string
+	HasValue bool
+}
+
+// Token is a piece of HTML.
+// Data is the text for Text tokens, the tag name as written for tags, and the content for comments and doctypes.
+// Raw is the source the Token was read from.
+type Token struct {
+	Type        Type
+	Data        string
+	Attrs       []Attr
+	SelfClosing bool
+	Raw         string
+}
+
+// Name of a tag Token in lower case.
+func (t Token) Name() string {
+	return strings.ToLower(t.Data)
+}
+
+// rawTextElements have text content that is only ended by their end tag.
+var rawTextElements = map[string]struct{}{
+	"iframe":   {},
+	"noembed":  {},
+	"noframes": {},
+	"script":   {},
+	"style":    {},
+	"textarea": {},
+	"title":    {},
+	"xmp":      {},
+}
+
+// Tokenize s into Tokens. It never fails; malformed markup is turned into Text and Comment tokens.
+func Tokenize(s string) []Token {
+	var tokens []Token
+	text := 0
+	i := 0
+
+	flushText := func(end int) {
+		if end > text {
+			tokens = append(tokens, Token{Type: Text, Data: s[text:end], Raw: s[text:end]})
+		}
+	}
+
+	for i < len(s) {
+		if s[i] != '<' || i+1 >= len(s) {
+			i++
+			continue
+		}
+
+		var t Token
+		var end int
+		switch c := s[i+1]; {
+		case isLetter(c):
+			t, end = readTag(s, i, StartTag)
+		case c == '/' && i+2 < len(s) && isLetter(s[i+2]):
+			t, end = readTag(s, i, EndTag)
+		case c == '/' && i+2 < len(s) && s[i+2] == '>':
+			// "</>" is ignored completely.
+			flushText(i)
+			i += 3
+			text = i
+			continue
+		case strings.HasPrefix(s[i:], "<!--"):
+			t, end = readComment(s, i)
+		case c == '!' || c == '?' || c == '/':
+			t, end = readBogus(s, i)
+		default:
+			i++
+			continue
+		}
+
+		flushText(i)
+		tokens = append(tokens, t)
+		i, text = end, end
+
+		if t.Type == StartTag && !t.SelfClosing {
+			name := t.Name()
+			if name == "plaintext" {
+				break
+			}
+			if _, ok := rawTextElements[name]; ok {
+				i = findEndTag(s, i, name)
+				flushText(i)
+				text = i
+			}
+		}
+	}
+	flushText(len(s))
+	return tokens
+}
+
+// readTag starting at the "<" at i.
+func readTag(s string, i int, typ Type) (Token, int) {
+	start := i
+	i++
+	if typ == EndTag {
+		i++
+	}
+
+	nameStart := i
+	for i < len(s) && !isSpace(s[i]) && s[i] != '/' && s[i] != '>' {
+		i++
+	}
+	t := Token{Type: typ, Data: s[nameStart:i]}
+
+	for {
+		for i < len(s) && (isSpace(s[i]) || s[i] == '/') {
+			if s[i] == '/' && i+1 < len(s) && s[i+1] == '>' {
+				t.SelfClosing = true
+			}
+			i++
+		}
+		if i >= len(s) {
+			break
+		}
+		if s[i] == '>' {
+			i++
+			break
+		}
+
+		var a Attr
+		a, i = readAttr(s, i)
+		if typ == StartTag && !hasAttr(t.Attrs, a.Name) {
+			t.Attrs = append(t.Attrs, a)
+		}
+	}
+
+	t.Raw = s[start:i]
+	return t, i
+}
+
+// readAttr starting at the attribute name at i.
+func readAttr(s string, i int) (Attr, int) {
+	nameStart := i
+	// An "=" as the first character is part of the name.
+	i++
+	for i < len(s) && !isSpace(s[i]) && s[i] != '/' && s[i] != '>' && s[i] != '=' {
+		i++
+	}
+	a := Attr{Name: s[nameStart:i]}
+
+	j := i
+	for j < len(s) && isSpace(s[j]) {
+		j++
+	}
+	if j >= len(s) || s[j] != '=' {
+		return a, i
+	}
+	j++
+	for j < len(s) && isSpace(s[j]) {
+		j++
+	}
+	a.HasValue = true
+	if j >= len(s) {
+		return a, j
+	}
+
+	switch q := s[j]; q {
+	case '"', '\'':
+		end := strings.IndexByte(s[j+1:], q)
+		if end < 0 {
+			a.Value = s[j+1:]
+			return a, len(s)
+		}
+		a.Value = s[j+1 : j+1+end]
+		return a, j + 1 + end + 1
+	default:
+		valueStart := j
+		for j < len(s) && !isSpace(s[j]) && s[j] != '>' {
+			j++
+		}
+		a.Value = s[valueStart:j]
+		return a, j
+	}
+}
+
+// readComment starting at the "<!--" at i.
+func readComment(s string, i int) (Token, int) {
+	start := i
+	i += len("<!--")
+	// "<!-->" and "<!--->" are empty comments.
+	for _, empty := range []string{">", "->"} {
+		if strings.HasPrefix(s[i:], empty) {
+			end := i + len(empty)
+			return Token{Type: Comment, Raw: s[start:end]}, end
+		}
+	}
+	end := strings.Index(s[i:], "-->")
+	if end < 0 {
+		return Token{Type: Comment, Data: s[i:], Raw: s[start:]}, len(s)
+	}
+	return Token{Type: Comment, Data: s[i : i+end], Raw: s[start : i+end+3]}, i + end + 3
+}
+
+// readBogus reads doctypes and bogus comments, starting at the "<" at i.
+func readBogus(s string, i int) (Token, int) {
+	start := i
+	end := strings.IndexByte(s[i:], '>')
+	if end < 0 {
+		end = len(s)
+	} else {
+		end = i + end + 1
+	}
+	raw := s[start:end]
+	data := strings.TrimSuffix(raw[2:], ">")
+	if len(data) >= len("doctype") && strings.EqualFold(data[:len("doctype")], "doctype") {
+		return Token{Type: Doctype, Data: strings.TrimSpace(data[len("doctype"):]), Raw: raw}, end
+	}
+	if raw[1] == '?' {
+		data = strings.TrimSuffix(raw[1:], ">")
+	}
+	return Token{Type: Comment, Data: data, Raw: raw}, end
+}
+
+// findEndTag returns the index of the end tag for the element with the given name, starting at i,
+// or the end of s if there is none.
+func findEndTag(s string, i int, name string) int {
+	for {
+		j := strings.Index(s[i:], "</")
+		if j < 0 {
+			return len(s)
+		}
+		j += i
+		k := j + 2 + len(name)
+		if k <= len(s) && strings.EqualFold(s[j+2:k], name) && (k == len(s) || isSpace(s[k]) || s[k] == '/' || s[k] == '>') {
+			return j
+		}
+		i = j + 2
+	}
+}
+
+func hasAttr(attrs []Attr, name string) bool {
+	for _, a := range attrs {
+		if strings.EqualFold(a.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\f', '\r':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package token
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []Token
+	}{
+		{
+			name:  "text",
+			input: "hat &amp; party",
+			expected: []Token{
+				{Type: Text, Data: "hat &amp; party", Raw: "hat &amp; party"},
+			},
+		},
+		{
+			name:  "elements with attributes",
+			input: `<div class="hat" id='party' hidden data-x=y>hi</div>`,
+			expected: []Token{
+				{Type: StartTag, Data: "div", Attrs: []Attr{
+					{Name: "class", Value: "hat", HasValue: true},
+					{Name: "id", Value: "party", HasValue: true},
+					{Name: "hidden"},
+					{Name: "data-x", Value: "y", HasValue: true},
+				}, Raw: `<div class="hat" id='party' hidden data-x=y>`},
+				{Type: Text, Data: "hi", Raw: "hi"},
+				{Type: EndTag, Data: "div", Raw: "</div>"},
+			},
+		},
+		{
+			name:  "self-closing tags and duplicate attributes",
+			input: `<br/><img src="a" SRC="b" />`,
+			expected: []Token{
+				{Type: StartTag, Data: "br", SelfClosing: true, Raw: "<br/>"},
+				{Type: StartTag, Data: "img", Attrs: []Attr{{Name: "src", Value: "a", HasValue: true}}, SelfClosing: true, Raw: `<img src="a" SRC="b" />`},
+			},
+		},
+		{
+			name:  "doctype and comments",
+			input: "<!DOCTYPE html><!-- hat --><!---->",
+			expected: []Token{
+				{Type: Doctype, Data: "html", Raw: "<!DOCTYPE html>"},
+				{Type: Comment, Data: " hat ", Raw: "<!-- hat -->"},
+				{Type: Comment, Data: "", Raw: "<!---->"},
+			},
+		},
+		{
+			name:  "raw text elements",
+			input: `<script>if (a<b) { x = "</div>" }</SCRIPT ><p>`,
+			expected: []Token{
+				{Type: StartTag, Data: "script", Raw: "<script>"},
+				{Type: Text, Data: `if (a<b) { x = "</div>" }`, Raw: `if (a<b) { x = "</div>" }`},
+				{Type: EndTag, Data: "SCRIPT", Raw: "</SCRIPT >"},
+				{Type: StartTag, Data: "p", Raw: "<p>"},
+			},
+		},
+		{
+			name:  "less than signs that are not tags",
+			input: "1 < 2 <3",
+			expected: []Token{
+				{Type: Text, Data: "1 < 2 <3", Raw: "1 < 2 <3"},
+			},
+		},
+		{
+			name:  "unterminated comment",
+			input: "a<!-- hat",
+			expected: []Token{
+				{Type: Text, Data: "a", Raw: "a"},
+				{Type: Comment, Data: " hat", Raw: "<!-- hat"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := Tokenize(test.input)
+			if !reflect.DeepEqual(test.expected, actual) {
+				t.Fatalf("expected %#v but got %#v", test.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package mathml
+
+import "github.com/melias122/html"
+
+// Accent returns an accent attribute, used on mo, mover, and munderover elements.
+func Accent(v string) html.Node {
+	return html.Attr("accent", v)
+}
+
+// AccentUnder returns an accentunder attribute, used on munder and munderover elements.
+func AccentUnder(v string) html.Node {
+	return html.Attr("accentunder", v)
+}
+
+// ActionType returns an actiontype attribute, used on maction elements.
+func ActionType(v string) html.Node {
+	return html.Attr("actiontype", v)
+}
+
+// AltText returns an alttext attribute, used on math elements.
+func AltText(v string) html.Node {
+	return html.Attr("alttext", v)
+}
+
+// Class returns a global class attribute.
+func Class(v string) html.Node {
+	return html.Attr("class", v)
+}
+
+// ColumnSpan returns a columnspan attribute, used on mtd elements.
+func ColumnSpan(v string) html.Node {
+	return html.Attr("columnspan", v)
+}
+
+// Depth returns a depth attribute, used on mpadded and mspace elements.
+func Depth(v string) html.Node {
+	return html.Attr("depth", v)
+}
+
+// Dir returns a global dir attribute.
+func Dir(v string) html.Node {
+	return html.Attr("dir", v)
+}
+
+// Display returns a display attribute, used on math elements.
+func Display(v string) html.Node {
+	return html.Attr("display", v)
+}
+
+// DisplayStyle returns a global displaystyle attribute.
+func DisplayStyle(v string) html.Node {
+	return html.Attr("displaystyle", v)
+}
+
+// Encoding returns an encoding attribute, used on annotation and annotation-xml elements.
+func Encoding(v string) html.Node {
+	return html.Attr("encoding", v)
+}
+
+// Fence returns a fence attribute, used on mo elements.
+func Fence(v string) html.Node {
+	return html.Attr("fence", v)
+}
+
+// Form returns a form attribute, used on mo elements.
+func Form(v string) html.Node {
+	return html.Attr("form", v)
+}
+
+// Height returns a height attribute, used on mpadded and mspace elements.
+func Height(v string) html.Node {
+	return html.Attr("height", v)
+}
+
+// ID returns a global id attribute.
+func ID(v string) html.Node {
+	return html.Attr("id", v)
+}
+
+// LargeOp returns a largeop attribute, used on mo elements.
+func LargeOp(v string) html.Node {
+	return html.Attr("largeop", v)
+}
+
+// LineThickness returns a linethickness attribute, used on mfrac elements.
+func LineThickness(v string) html.Node {
+	return html.Attr("linethickness", v)
+}
+
+// LSpace returns a lspace attribute, used on mo and mpadded elements.
+func LSpace(v string) html.Node {
+	return html.Attr("lspace", v)
+}
+
+// MathBackground returns a global mathbackground attribute.
+func MathBackground(v string) html.Node {
+	return html.Attr("mathbackground", v)
+}
+
+// MathColor returns a global mathcolor attribute.
+func MathColor(v string) html.Node {
+	return html.Attr("mathcolor", v)
+}
+
+// MathSize returns a global mathsize attribute.
+func MathSize(v string) html.Node {
+	return html.Attr("mathsize", v)
+}
+
+// MathVariant returns a global mathvariant attribute.
+func MathVariant(v string) html.Node {
+	return html.Attr("mathvariant", v)
+}
+
+// MaxSize returns a maxsize attribute, used on mo elements.
+func MaxSize(v string) html.Node {
+	return html.Attr("maxsize", v)
+}
+
+// MinSize returns a minsize attribute, used on mo elements.
+func MinSize(v string) html.Node {
+	return html.Attr("minsize", v)
+}
+
+// MovableLimits returns a movablelimits attribute, used on mo elements.
+func MovableLimits(v string) html.Node {
+	return html.Attr("movablelimits", v)
+}
+
+// Nonce returns a global nonce attribute.
+func Nonce(v string) html.Node {
+	return html.Attr("nonce", v)
+}
+
+// RowSpan returns a rowspan attribute, used on mtd elements.
+func RowSpan(v string) html.Node {
+	return html.Attr("rowspan", v)
+}
+
+// RSpace returns a rspace attribute, used on mo elements.
+func RSpace(v string) html.Node {
+	return html.Attr("rspace", v)
+}
+
+// ScriptLevel returns a global scriptlevel attribute.
+func ScriptLevel(v string) html.Node {
+	return html.Attr("scriptlevel", v)
+}
+
+// Selection returns a selection attribute, used on maction elements.
+func Selection(v string) html.Node {
+	return html.Attr("selection", v)
+}
+
+// Separator returns a separator attribute, used on mo elements.
+func Separator(v string) html.Node {
+	return html.Attr("separator", v)
+}
+
+// Stretchy returns a stretchy attribute, used on mo elements.
+func Stretchy(v string) html.Node {
+	return html.Attr("stretchy", v)
+}
+
+// Style returns a global style attribute.
+func Style(v string) html.Node {
+	return html.Attr("style", v)
+}
+
+// Symmetric returns a symmetric attribute, used on mo elements.
+func Symmetric(v string) html.Node {
+	return html.Attr("symmetric", v)
+}
+
+// TabIndex returns a global tabindex attribute.
+func TabIndex(v string) html.Node {
+	return html.Attr("tabindex", v)
+}
+
+// VOffset returns a voffset attribute, used on mpadded elements.
+func VOffset(v string) html.Node {
+	return html.Attr("voffset", v)
+}
+
+// Width returns a width attribute, used on mpadded and mspace elements.
+func Width(v string) html.Node {
+	return html.Attr("width", v)
+}
@@ -0,0 +1,59 @@
+// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package mathml
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/melias122/html"
+)
+
+func TestSimpleAttributes(t *testing.T) {
+	cases := map[string]func(string) html.Node{
+		"accent":         Accent,
+		"accentunder":    AccentUnder,
+		"actiontype":     ActionType,
+		"alttext":        AltText,
+		"class":          Class,
+		"columnspan":     ColumnSpan,
+		"depth":          Depth,
+		"dir":            Dir,
+		"display":        Display,
+		"displaystyle":   DisplayStyle,
+		"encoding":       Encoding,
+		"fence":          Fence,
+		"form":           Form,
+		"height":         Height,
+		"id":             ID,
+		"largeop":        LargeOp,
+		"linethickness":  LineThickness,
+		"lspace":         LSpace,
+		"mathbackground": MathBackground,
+		"mathcolor":      MathColor,
+		"mathsize":       MathSize,
+		"mathvariant":    MathVariant,
+		"maxsize":        MaxSize,
+		"minsize":        MinSize,
+		"movablelimits":  MovableLimits,
+		"nonce":          Nonce,
+		"rowspan":        RowSpan,
+		"rspace":         RSpace,
+		"scriptlevel":    ScriptLevel,
+		"selection":      Selection,
+		"separator":      Separator,
+		"stretchy":       Stretchy,
+		"style":          Style,
+		"symmetric":      Symmetric,
+		"tabindex":       TabIndex,
+		"voffset":        VOffset,
+		"width":          Width,
+	}
+
+	for name, fn := range cases {
+		t.Run(fmt.Sprintf(`should output %v="hat"`, name), func(t *testing.T) {
+			n := html.El("div", fn("hat"))
+			Equal(t, fmt.Sprintf(`<div %v="hat"></div>`, name), n)
+		})
+	}
+}
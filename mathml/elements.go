@@ -0,0 +1,14 @@
+// Package mathml provides MathML Core elements and attributes, for writing formulas.
+// See https://developer.mozilla.org/en-US/docs/Web/MathML/Element for an overview.
+package mathml
+
+import (
+	"github.com/melias122/html"
+)
+
+//go:generate go run ../internal/cmd/generate -spec ../internal/spec/mathml.json
+
+// Math returns a math element with the MathML namespace and the given children.
+func Math(children ...html.Node) html.Node {
+	return html.El("math", html.Attr("xmlns", "http://www.w3.org/1998/Math/MathML"), html.Group(children))
+}
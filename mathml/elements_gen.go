@@ -0,0 +1,150 @@
+// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package mathml
+
+import "github.com/melias122/html"
+
+// Annotation returns an annotation element with the given children.
+func Annotation(children ...html.Node) html.Node {
+	return html.El("annotation", children...)
+}
+
+// AnnotationXML returns an annotation-xml element with the given children.
+func AnnotationXML(children ...html.Node) html.Node {
+	return html.El("annotation-xml", children...)
+}
+
+// Maction returns a maction element with the given children.
+func Maction(children ...html.Node) html.Node {
+	return html.El("maction", children...)
+}
+
+// Merror returns a merror element with the given children.
+func Merror(children ...html.Node) html.Node {
+	return html.El("merror", children...)
+}
+
+// Mfrac returns a mfrac element with the given children.
+func Mfrac(children ...html.Node) html.Node {
+	return html.El("mfrac", children...)
+}
+
+// Mi returns a mi element with the given children.
+func Mi(children ...html.Node) html.Node {
+	return html.El("mi", children...)
+}
+
+// Mmultiscripts returns a mmultiscripts element with the given children.
+func Mmultiscripts(children ...html.Node) html.Node {
+	return html.El("mmultiscripts", children...)
+}
+
+// Mn returns a mn element with the given children.
+func Mn(children ...html.Node) html.Node {
+	return html.El("mn", children...)
+}
+
+// Mo returns a mo element with the given children.
+func Mo(children ...html.Node) html.Node {
+	return html.El("mo", children...)
+}
+
+// Mover returns a mover element with the given children.
+func Mover(children ...html.Node) html.Node {
+	return html.El("mover", children...)
+}
+
+// Mpadded returns a mpadded element with the given children.
+func Mpadded(children ...html.Node) html.Node {
+	return html.El("mpadded", children...)
+}
+
+// Mphantom returns a mphantom element with the given children.
+func Mphantom(children ...html.Node) html.Node {
+	return html.El("mphantom", children...)
+}
+
+// Mprescripts returns a mprescripts element with the given children.
+func Mprescripts(children ...html.Node) html.Node {
+	return html.El("mprescripts", children...)
+}
+
+// Mroot returns a mroot element with the given children.
+func Mroot(children ...html.Node) html.Node {
+	return html.El("mroot", children...)
+}
+
+// Mrow returns a mrow element with the given children.
+func Mrow(children ...html.Node) html.Node {
+	return html.El("mrow", children...)
+}
+
+// Ms returns a ms element with the given children.
+func Ms(children ...html.Node) html.Node {
+	return html.El("ms", children...)
+}
+
+// Mspace returns a mspace element with the given children.
+func Mspace(children ...html.Node) html.Node {
+	return html.El("mspace", children...)
+}
+
+// Msqrt returns a msqrt element with the given children.
+func Msqrt(children ...html.Node) html.Node {
+	return html.El("msqrt", children...)
+}
+
+// Mstyle returns a mstyle element with the given children.
+func Mstyle(children ...html.Node) html.Node {
+	return html.El("mstyle", children...)
+}
+
+// Msub returns a msub element with the given children.
+func Msub(children ...html.Node) html.Node {
+	return html.El("msub", children...)
+}
+
+// Msubsup returns a msubsup element with the given children.
+func Msubsup(children ...html.Node) html.Node {
+	return html.El("msubsup", children...)
+}
+
+// Msup returns a msup element with the given children.
+func Msup(children ...html.Node) html.Node {
+	return html.El("msup", children...)
+}
+
+// Mtable returns a mtable element with the given children.
+func Mtable(children ...html.Node) html.Node {
+	return html.El("mtable", children...)
+}
+
+// Mtd returns a mtd element with the given children.
+func Mtd(children ...html.Node) html.Node {
+	return html.El("mtd", children...)
+}
+
+// Mtext returns a mtext element with the given children.
+func Mtext(children ...html.Node) html.Node {
+	return html.El("mtext", children...)
+}
+
+// Mtr returns a mtr element with the given children.
+func Mtr(children ...html.Node) html.Node {
+	return html.El("mtr", children...)
+}
+
+// Munder returns a munder element with the given children.
+func Munder(children ...html.Node) html.Node {
+	return html.El("munder", children...)
+}
+
+// Munderover returns a munderover element with the given children.
+func Munderover(children ...html.Node) html.Node {
+	return html.El("munderover", children...)
+}
+
+// Semantics returns a semantics element with the given children.
+func Semantics(children ...html.Node) html.Node {
+	return html.El("semantics", children...)
+}
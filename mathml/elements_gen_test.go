@@ -0,0 +1,51 @@
+// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package mathml
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/melias122/html"
+)
+
+func TestSimpleElements(t *testing.T) {
+	cases := map[string]func(...html.Node) html.Node{
+		"annotation":     Annotation,
+		"annotation-xml": AnnotationXML,
+		"maction":        Maction,
+		"merror":         Merror,
+		"mfrac":          Mfrac,
+		"mi":             Mi,
+		"mmultiscripts":  Mmultiscripts,
+		"mn":             Mn,
+		"mo":             Mo,
+		"mover":          Mover,
+		"mpadded":        Mpadded,
+		"mphantom":       Mphantom,
+		"mprescripts":    Mprescripts,
+		"mroot":          Mroot,
+		"mrow":           Mrow,
+		"ms":             Ms,
+		"mspace":         Mspace,
+		"msqrt":          Msqrt,
+		"mstyle":         Mstyle,
+		"msub":           Msub,
+		"msubsup":        Msubsup,
+		"msup":           Msup,
+		"mtable":         Mtable,
+		"mtd":            Mtd,
+		"mtext":          Mtext,
+		"mtr":            Mtr,
+		"munder":         Munder,
+		"munderover":     Munderover,
+		"semantics":      Semantics,
+	}
+
+	for name, fn := range cases {
+		t.Run(fmt.Sprintf("should output %v", name), func(t *testing.T) {
+			n := fn(html.Attr("id", "hat"))
+			Equal(t, fmt.Sprintf(`<%v id="hat"></%v>`, name, name), n)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package mathml
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/melias122/html"
+	"github.com/melias122/html/htmltest"
+)
+
+// Equal checks for equality between the given expected string and the rendered Node string.
+func Equal(t *testing.T, expected string, actual html.Node) {
+	t.Helper()
+
+	var b strings.Builder
+	_ = actual.Render(&b)
+	if expected != b.String() {
+		t.Fatalf(`expected "%v" but got "%v"`, expected, b.String())
+	}
+}
+
+func TestMath(t *testing.T) {
+	t.Run("outputs math element with mathml namespace attribute", func(t *testing.T) {
+		htmltest.Equal(t, `<math xmlns="http://www.w3.org/1998/Math/MathML"><mi>x</mi></math>`, Math(Mi(html.Text("x"))))
+	})
+
+	t.Run("escapes text like other nodes", func(t *testing.T) {
+		htmltest.Equal(t, `<math xmlns="http://www.w3.org/1998/Math/MathML"><mo>&lt;</mo></math>`, Math(Mo(html.Text("<"))))
+	})
+}
+
+func ExampleMath() {
+	e := Math(Display("block"),
+		Mfrac(
+			Mi(html.Text("a")),
+			Msqrt(Mi(html.Text("b"))),
+		),
+	)
+	_ = e.Render(os.Stdout)
+	// Output: <math xmlns="http://www.w3.org/1998/Math/MathML" display="block"><mfrac><mi>a</mi><msqrt><mi>b</mi></msqrt></mfrac></math>
+}
@@ -0,0 +1,291 @@
+package html
+
+import (
+	"strings"
+
+	"github.com/melias122/html/internal/elements"
+	"github.com/melias122/html/internal/token"
+)
+
+// Minify renders the Node n as minified HTML, that results in the same document as rendering n directly.
+// It omits optional start and end tags where the HTML specification allows it,
+// see https://html.spec.whatwg.org/multipage/syntax.html#optional-tags.
+// It removes quotes around attribute values that don't need them, renders empty and boolean attributes
+// by name only, and collapses runs of whitespace in text to a single space, except inside Pre, Textarea,
+// Script, and StyleEl elements. Whitespace-only text is removed where it's never rendered,
+// such as between table rows.
+//
+// The whole Node is rendered before minifying, so Flush has no effect inside it.
+func Minify(n Node) Node {
+	return &formatter{n: n, format: func(w *strings.Builder, root *tokenTree) {
+		collapseWhitespace(root)
+		m := minifier{w: w}
+		m.children(root)
+	}}
+}
+
+// whitespaceIgnoringElements have whitespace-only text content that is never rendered.
+var whitespaceIgnoringElements = map[string]struct{}{
+	"colgroup": {},
+	"datalist": {},
+	"dl":       {},
+	"head":     {},
+	"html":     {},
+	"ol":       {},
+	"optgroup": {},
+	"select":   {},
+	"table":    {},
+	"tbody":    {},
+	"tfoot":    {},
+	"thead":    {},
+	"tr":       {},
+	"ul":       {},
+}
+
+// collapseWhitespace in text in t and its children, and remove whitespace-only text that is never rendered.
+func collapseWhitespace(t *tokenTree) {
+	if elements.PreservesWhitespace(t.name()) {
+		return
+	}
+	_, ignoring := whitespaceIgnoringElements[t.name()]
+
+	children := t.children[:0]
+	for _, c := range t.children {
+		if c.token.Type == token.Text {
+			text := collapseSpace(c.token.Data)
+			if text == "" || text == " " && ignoring {
+				continue
+			}
+			c.token.Data, c.token.Raw = text, text
+		}
+		collapseWhitespace(c)
+		children = append(children, c)
+	}
+	t.children = children
+}
+
+// collapseSpace replaces runs of ASCII whitespace with a single space.
+func collapseSpace(s string) string {
+	var b strings.Builder
+	space := false
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case ' ', '\t', '\n', '\f', '\r':
+			space = true
+		default:
+			if space {
+				b.WriteByte(' ')
+				space = false
+			}
+			b.WriteByte(s[i])
+		}
+	}
+	if space {
+		b.WriteByte(' ')
+	}
+	return b.String()
+}
+
+// minifier writes a tokenTree as minified HTML.
+type minifier struct {
+	w *strings.Builder
+}
+
+func (m *minifier) children(t *tokenTree) {
+	// prevOmitted is the element before the current one, if its end tag was omitted.
+	var prevOmitted *tokenTree
+	for i, c := range t.children {
+		var next *tokenTree
+		if i+1 < len(t.children) {
+			next = t.children[i+1]
+		}
+
+		if c.token.Type != token.StartTag {
+			m.w.WriteString(c.token.Raw)
+			prevOmitted = nil
+			continue
+		}
+
+		if !canOmitStartTag(c, prevOmitted) {
+			m.startTag(c.token)
+		}
+		m.children(c)
+
+		prevOmitted = nil
+		if c.end != nil {
+			if canOmitEndTag(c, next) {
+				prevOmitted = c
+			} else {
+				m.w.WriteString("</" + c.token.Data + ">")
+			}
+		}
+	}
+}
+
+func (m *minifier) startTag(t token.Token) {
+	selfClosing := t.SelfClosing && !isVoidElement(t.Name())
+	m.w.WriteString("<" + t.Data)
+	for i, a := range t.Attrs {
+		m.w.WriteString(" " + a.Name)
+
+		_, boolean := booleanAttributes[strings.ToLower(a.Name)]
+		if !a.HasValue || a.Value == "" || boolean && strings.EqualFold(a.Value, a.Name) {
+			continue
+		}
+
+		m.w.WriteByte('=')
+		switch {
+		// The slash of a self-closing tag would become part of an unquoted value before it.
+		case !needsQuotes(a.Value) && !(selfClosing && i == len(t.Attrs)-1):
+			m.w.WriteString(a.Value)
+		case strings.Contains(a.Value, `"`):
+			m.w.WriteString("'" + a.Value + "'")
+		default:
+			m.w.WriteString(`"` + a.Value + `"`)
+		}
+	}
+	if selfClosing {
+		m.w.WriteString("/")
+	}
+	m.w.WriteString(">")
+}
+
+// needsQuotes returns whether an attribute value cannot be written unquoted.
+// See https://html.spec.whatwg.org/multipage/syntax.html#unquoted
+func needsQuotes(v string) bool {
+	if v == "" {
+		return true
+	}
+	return strings.ContainsAny(v, " \t\n\f\r\"'=<>`")
+}
+
+// canOmitStartTag of element t, given the element before it if its end tag was omitted.
+func canOmitStartTag(t, prevOmitted *tokenTree) bool {
+	if len(t.token.Attrs) > 0 {
+		return false
+	}
+	// The parent of top-level elements is unknown, unless it's the document.
+	if t.parent.parent == nil && t.name() != "html" {
+		return false
+	}
+
+	var first *tokenTree
+	if len(t.children) > 0 {
+		first = t.children[0]
+	}
+
+	switch t.name() {
+	case "html":
+		return first == nil || first.token.Type != token.Comment
+	case "head":
+		return first == nil || first.token.Type == token.StartTag
+	case "body":
+		if first == nil {
+			return true
+		}
+		switch first.token.Type {
+		case token.Comment:
+			return false
+		case token.Text:
+			return !isSpace(first.token.Data[0])
+		case token.StartTag:
+			switch first.name() {
+			case "meta", "noscript", "link", "script", "style", "template":
+				return false
+			}
+		}
+		return true
+	case "colgroup":
+		return first != nil && first.name() == "col" && (prevOmitted == nil || prevOmitted.name() != "colgroup")
+	case "tbody":
+		if first == nil || first.name() != "tr" {
+			return false
+		}
+		if prevOmitted != nil {
+			switch prevOmitted.name() {
+			case "tbody", "thead", "tfoot":
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// canOmitEndTag of element t, given the node after it, which is nil if t is the last in its parent.
+func canOmitEndTag(t, next *tokenTree) bool {
+	// What comes after the last top-level element is unknown, unless it's the document element.
+	if next == nil && t.parent.parent == nil && t.name() != "html" {
+		return false
+	}
+	nextName := ""
+	if next != nil {
+		nextName = next.name()
+	}
+	nextIs := func(names ...string) bool {
+		for _, name := range names {
+			if nextName == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch t.name() {
+	case "html", "body":
+		return next == nil || next.token.Type != token.Comment
+	case "head", "colgroup", "caption":
+		if next == nil {
+			return true
+		}
+		switch next.token.Type {
+		case token.Comment:
+			return false
+		case token.Text:
+			return !isSpace(next.token.Data[0])
+		}
+		return true
+	case "li":
+		return next == nil || nextIs("li")
+	case "dt":
+		return nextIs("dt", "dd")
+	case "dd":
+		return next == nil || nextIs("dd", "dt")
+	case "p":
+		if next == nil {
+			switch t.parent.name() {
+			case "a", "audio", "del", "ins", "map", "noscript", "video":
+				return false
+			}
+			return !strings.Contains(t.parent.name(), "-")
+		}
+		return nextIs("address", "article", "aside", "blockquote", "details", "dialog", "div", "dl", "fieldset",
+			"figcaption", "figure", "footer", "form", "h1", "h2", "h3", "h4", "h5", "h6", "header", "hgroup", "hr",
+			"main", "menu", "nav", "ol", "p", "pre", "search", "section", "table", "ul")
+	case "rt", "rp":
+		return next == nil || nextIs("rt", "rp")
+	case "optgroup":
+		return next == nil || nextIs("optgroup", "hr")
+	case "option":
+		return next == nil || nextIs("option", "optgroup", "hr")
+	case "thead":
+		return nextIs("tbody", "tfoot")
+	case "tbody":
+		return next == nil || nextIs("tbody", "tfoot")
+	case "tfoot":
+		return next == nil
+	case "tr":
+		return next == nil || nextIs("tr")
+	case "td", "th":
+		return next == nil || nextIs("td", "th")
+	}
+	return false
+}
+
+func isSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\f', '\r':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package html
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestMinify(t *testing.T) {
+	t.Run("omits optional tags in a document", func(t *testing.T) {
+		n := Minify(HTML5(HTML5Props{
+			Title:    "Hat",
+			Language: "en",
+			Body: []Node{
+				Ul(Li(Text("party")), Li(Text("turtle"))),
+				P(Text("Hats")),
+				P(Text("More hats")),
+			},
+		}))
+
+		Equal(t, `<!doctype html><html lang=en><meta charset=utf-8>`+
+			`<meta name=viewport content="width=device-width, initial-scale=1"><title>Hat</title>`+
+			`<ul><li>party<li>turtle</ul><p>Hats<p>More hats`, n)
+	})
+
+	t.Run("omits optional tags in tables", func(t *testing.T) {
+		n := Minify(Div(Table(
+			Caption(Text("Hats")),
+			ColGroup(Col(), Col()),
+			THead(Tr(Th(Text("Name")), Th(Text("Size")))),
+			TBody(Tr(Td(Text("party")), Td(Text("M"))), Tr(Td(Text("turtle")), Td(Text("L")))),
+			TFoot(Tr(Td(Text("2 hats")))),
+		)))
+
+		Equal(t, `<div><table><caption>Hats<col><col><thead><tr><th>Name<th>Size`+
+			`<tbody><tr><td>party<td>M<tr><td>turtle<td>L<tfoot><tr><td>2 hats</table></div>`, n)
+	})
+
+	t.Run("keeps tbody start tags after tbody with omitted end tag", func(t *testing.T) {
+		n := Minify(Div(Table(TBody(Tr()), TBody(Tr()))))
+		Equal(t, `<div><table><tr><tbody><tr></table></div>`, n)
+	})
+
+	t.Run("keeps start tags with attributes", func(t *testing.T) {
+		n := Minify(Div(Table(TBody(Class("hats"), Tr()))))
+		Equal(t, `<div><table><tbody class=hats><tr></table></div>`, n)
+	})
+
+	t.Run("keeps p end tag if parent is a or followed by inline content", func(t *testing.T) {
+		n := Minify(Div(A(P(Text("hat"))), P(Text("hat")), Span()))
+		Equal(t, `<div><a><p>hat</p></a><p>hat</p><span></span></div>`, n)
+	})
+
+	t.Run("keeps end tags that close top-level elements", func(t *testing.T) {
+		n := Minify(Raw("<li>hat</li><p>hat</p>"))
+		Equal(t, `<ul><li>hat</li><p>hat</p></ul>`, El("ul", n))
+	})
+
+	t.Run("omits end tags in lists and selects", func(t *testing.T) {
+		n := Minify(Div(
+			Dl(Dt(Text("hat")), Dd(Text("party")), Dt(Text("cap")), Dd(Text("base"))),
+			Select(OptGroup(Option(Text("a")), Option(Text("b"))), OptGroup(Option(Text("c")))),
+		))
+		Equal(t, `<div><dl><dt>hat<dd>party<dt>cap<dd>base</dl>`+
+			`<select><optgroup><option>a<option>b<optgroup><option>c</select></div>`, n)
+	})
+
+	t.Run("keeps body start tag if body starts with a script or whitespace", func(t *testing.T) {
+		n := Minify(HTML(Head(), Body(Script(Raw("x()")))))
+		Equal(t, `<body><script>x()</script>`, n)
+
+		n = Minify(HTML(Body(Text(" hat"))))
+		Equal(t, `<body> hat`, n)
+	})
+
+	t.Run("keeps tags followed by comments", func(t *testing.T) {
+		n := Minify(Raw("<html><head></head><!-- hat --><body></body><!-- hat --></html>"))
+		Equal(t, `</head><!-- hat --></body><!-- hat -->`, n)
+	})
+
+	t.Run("removes attribute quotes where allowed and collapses boolean attributes", func(t *testing.T) {
+		n := Minify(Div(Input(Type("text"), Value(""), Disabled(), Required(), Attr("checked", "checked"),
+			Class("a b"), TitleAttr(`"hat"`), Attr("data-x", "a=b"), Href("/hats?size=m&amp;color=red")),
+			Raw(`<span title='say "hi"'></span>`)))
+		Equal(t, `<div><input type=text value disabled required checked class="a b" title=&#34;hat&#34; data-x="a=b" `+
+			`href="/hats?size=m&amp;amp;color=red"><span title='say "hi"'></span></div>`, n)
+	})
+
+	t.Run("collapses whitespace in text except in preformatted elements", func(t *testing.T) {
+		n := Minify(Div(
+			Text("  party \n\t hat  "),
+			Pre(Text("  a\n  b")),
+			Textarea(Text("  a\n  b")),
+			Script(Raw("if (a) {\n  b()\n}")),
+			Ul(Text("\n  "), Li(Text("hat"))),
+		))
+		Equal(t, "<div> party hat <pre>  a\n  b</pre><textarea>  a\n  b</textarea>"+
+			"<script>if (a) {\n  b()\n}</script><ul><li>hat</ul></div>", n)
+	})
+
+	t.Run("keeps self-closing foreign elements", func(t *testing.T) {
+		n := Minify(Raw(`<svg viewBox="0 0 24 24"><path d="M4 6h16"/><br/></svg>`))
+		Equal(t, `<svg viewBox="0 0 24 24"><path d="M4 6h16"/><br></svg>`, n)
+	})
+
+	t.Run("keeps quotes on the last attribute of self-closing elements", func(t *testing.T) {
+		n := Minify(Raw(`<svg><circle r="5" cx="1"/><circle r="5" cx="1"></circle><circle hidden/></svg>`))
+		Equal(t, `<svg><circle r=5 cx="1"/><circle r=5 cx=1></circle><circle hidden/></svg>`, n)
+	})
+
+	t.Run("returns render errors", func(t *testing.T) {
+		Error(t, Minify(El("div")).Render(&erroringWriter{}))
+	})
+}
+
+func BenchmarkMinify(b *testing.B) {
+	n := HTML5(HTML5Props{
+		Title: "Hat",
+		Body:  []Node{Ul(Li(Text("party")), Li(Text("turtle"))), P(Text("Hats"))},
+	})
+
+	for i := 0; i < b.N; i++ {
+		_ = Minify(n).Render(&strings.Builder{})
+	}
+}
+
+func ExampleMinify() {
+	e := Minify(Div(Ul(Li(Class("party"), Text("Party hat")), Li(Text("Turtle hat")))))
+	_ = e.Render(os.Stdout)
+	// Output: <div><ul><li class=party>Party hat<li>Turtle hat</ul></div>
+}
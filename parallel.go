@@ -0,0 +1,122 @@
+package html
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// Parallel renders its children concurrently, each to a separate buffer, and then writes them in order.
+// Use it for independent subtrees that are slow to render, such as widgets loading their own data
+// with FromContext. At most runtime.GOMAXPROCS(0) children are rendered at the same time, see ParallelLimit.
+//
+// The output is the same as if the children were rendered in order. If a child fails to render,
+// the others are cancelled through the render context, nothing is written, and the first error is returned.
+// Children must be of ElementType, attribute children are ignored.
+func Parallel(children ...Node) Node {
+	return ParallelLimit(runtime.GOMAXPROCS(0), children...)
+}
+
+// ParallelLimit is like Parallel, but renders at most limit children at the same time.
+// A limit less than 1 means no limit.
+func ParallelLimit(limit int, children ...Node) Node {
+	return &parallel{limit: limit, children: children}
+}
+
+type parallel struct {
+	limit    int
+	children []Node
+}
+
+// Render satisfies Node.
+func (p *parallel) Render(w io.Writer) error {
+	return p.RenderContext(context.Background(), w)
+}
+
+// RenderContext satisfies ContextRenderer.
+func (p *parallel) RenderContext(ctx context.Context, w io.Writer) error {
+	children := flatten(p.children, ElementType)
+	if len(children) == 0 {
+		return ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	limit := p.limit
+	if limit < 1 || limit > len(children) {
+		limit = len(children)
+	}
+
+	var (
+		buffers  = make([]bytes.Buffer, len(children))
+		sem      = make(chan struct{}, limit)
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		panicked interface{}
+	)
+	fail := func(err error, r interface{}) {
+		once.Do(func() {
+			firstErr, panicked = err, r
+			cancel()
+		})
+	}
+
+loop:
+	for i, c := range children {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+
+		wg.Add(1)
+		go func(i int, c Node) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			defer func() {
+				if r := recover(); r != nil {
+					fail(nil, r)
+				}
+			}()
+
+			if err := RenderCtx(ctx, c, &buffers[i]); err != nil {
+				fail(err, nil)
+			}
+		}(i, c)
+	}
+	wg.Wait()
+
+	if panicked != nil {
+		panic(panicked)
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for i := range buffers {
+		if _, err := buffers[i].WriteTo(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Type satisfies nodeTypeDescriber.
+func (p *parallel) Type() NodeType {
+	return ElementType
+}
+
+// String satisfies fmt.Stringer.
+func (p *parallel) String() string {
+	var b strings.Builder
+	_ = p.Render(&b)
+	return b.String()
+}
@@ -0,0 +1,153 @@
+package html
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestParallel(t *testing.T) {
+	t.Run("renders children in document order", func(t *testing.T) {
+		release := make(chan struct{})
+		n := El("div", ParallelLimit(2,
+			El("span", NodeFunc(func(w io.Writer) error {
+				<-release
+				_, err := w.Write([]byte("slow"))
+				return err
+			})),
+			nil,
+			Group([]Node{El("span", NodeFunc(func(w io.Writer) error {
+				defer close(release)
+				_, err := w.Write([]byte("fast"))
+				return err
+			}))}),
+		))
+		Equal(t, "<div><span>slow</span><span>fast</span></div>", n)
+	})
+
+	t.Run("renders nothing without children", func(t *testing.T) {
+		Equal(t, "<div></div>", El("div", Parallel()))
+	})
+
+	t.Run("ignores attribute children", func(t *testing.T) {
+		Equal(t, "<div><br></div>", El("div", Parallel(Attr("id", "hat"), El("br"))))
+	})
+
+	t.Run("renders at most limit children at the same time", func(t *testing.T) {
+		var mu sync.Mutex
+		var current, max int
+		child := NodeFunc(func(io.Writer) error {
+			mu.Lock()
+			current++
+			if current > max {
+				max = current
+			}
+			mu.Unlock()
+			runtime.Gosched()
+
+			mu.Lock()
+			current--
+			mu.Unlock()
+			return nil
+		})
+
+		var children []Node
+		for i := 0; i < 20; i++ {
+			children = append(children, child)
+		}
+		if err := ParallelLimit(2, children...).Render(&strings.Builder{}); err != nil {
+			t.Fatal(err)
+		}
+		if max > 2 {
+			t.Fatal("max concurrent renders is", max)
+		}
+	})
+
+	t.Run("cancels other children and returns the first error", func(t *testing.T) {
+		n := El("div", ParallelLimit(0,
+			ContextNodeFunc(func(ctx context.Context, w io.Writer) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}),
+			NodeFunc(func(io.Writer) error {
+				return errors.New("no hats")
+			}),
+		))
+
+		var b strings.Builder
+		err := n.Render(&b)
+		if err == nil || err.Error() != "no hats" {
+			t.Fatal("error is", err)
+		}
+		if b.String() != "<div>" {
+			t.Fatal("got", b.String())
+		}
+	})
+
+	t.Run("passes the render context on to children", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), contextKey("hat"), "partyhat")
+		n := Parallel(FromContext(func(ctx context.Context) Node {
+			return Text(ctx.Value(contextKey("hat")).(string))
+		}))
+
+		var b strings.Builder
+		if err := RenderCtx(ctx, n, &b); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != "partyhat" {
+			t.Fatal("got", b.String())
+		}
+	})
+
+	t.Run("returns the context error if the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		n := Parallel(NodeFunc(func(io.Writer) error {
+			cancel()
+			return nil
+		}))
+
+		if err := n.(ContextRenderer).RenderContext(ctx, &strings.Builder{}); !errors.Is(err, context.Canceled) {
+			t.Fatal("error is", err)
+		}
+	})
+
+	t.Run("panics in the rendering goroutine if a child panics", func(t *testing.T) {
+		panicked := false
+		defer func() {
+			if err := recover(); err != nil {
+				panicked = true
+			}
+			if !panicked {
+				t.FailNow()
+			}
+		}()
+		_ = Parallel(El("div"), NodeFunc(func(io.Writer) error {
+			panic("no hats")
+		})).Render(&strings.Builder{})
+	})
+}
+
+func BenchmarkParallel(b *testing.B) {
+	var children []Node
+	for i := 0; i < 10; i++ {
+		children = append(children, El("div", El("span", Text("hat"))))
+	}
+
+	for i := 0; i < b.N; i++ {
+		_ = Parallel(children...).Render(&strings.Builder{})
+	}
+}
+
+func ExampleParallel() {
+	e := El("div", Parallel(
+		El("section", Text("Party hats")),
+		El("section", Text("Turtle hats")),
+	))
+	_ = e.Render(os.Stdout)
+	// Output: <div><section>Party hats</section><section>Turtle hats</section></div>
+}
@@ -0,0 +1,240 @@
+package html
+
+import (
+	stdhtml "html"
+	"io"
+	"strings"
+
+	"github.com/melias122/html/internal/token"
+)
+
+// Parse an HTML document into a Node tree of Elements, Attributes, Text, and Raw Nodes,
+// so it can be inspected and changed like components, see for example Query and Transform.
+// The document is returned with Doctype if it has one.
+// Nodes outside the html element, such as comments, are moved into it, like browsers do.
+//
+// See ParseFragment for how the HTML is parsed.
+func Parse(r io.Reader) (Node, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := token.Tokenize(string(b))
+	hasDoctype := false
+	if i := firstNonSpace(tokens); i < len(tokens) && tokens[i].Type == token.Doctype {
+		hasDoctype = true
+		tokens = tokens[i+1:]
+	}
+
+	var doc *Element
+	var before, after []Node
+	for _, n := range parseTokens(tokens) {
+		if t, ok := n.(TextNode); ok && strings.TrimSpace(string(t)) == "" {
+			continue
+		}
+		switch e, ok := n.(*Element); {
+		case doc == nil && ok && e.Name == "html":
+			doc = e
+		case doc == nil:
+			before = append(before, n)
+		default:
+			after = append(after, n)
+		}
+	}
+
+	var root Node
+	switch {
+	case doc != nil:
+		doc.Children = append(append(before, doc.Children...), after...)
+		root = doc
+	case len(before) == 1:
+		root = before[0]
+	case len(before) > 1:
+		root = El("html", before...)
+	}
+
+	if hasDoctype {
+		return Doctype(root), nil
+	}
+	return root, nil
+}
+
+// ParseFragment parses HTML, such as from a CMS or a template, into Nodes, which can be used with Group.
+//
+// The HTML is tokenized following the HTML 5 rules. Element and attribute names are lower-cased,
+// and SVG names get their proper case, like viewBox, as in browsers. Self-closing tags are honored in SVG and MathML.
+// Character references like "&amp;" in text and attribute values are decoded,
+// so the returned Text and Attr Nodes are escaped again when rendered.
+// Void elements such as Br never have children, and the contents of the raw text elements Script and StyleEl
+// are kept as Raw Nodes. Comments are kept as Raw Nodes too.
+//
+// End tags that the HTML 5 specification allows to be omitted, such as for Li, P, and Td, are implied
+// where the next start tag closes the element, and end tags without an open element are ignored.
+// Unlike browsers, ParseFragment doesn't add elements that are implied, such as Head, Body, or TBody,
+// and doesn't move misplaced content, such as text in a Table.
+func ParseFragment(r io.Reader) ([]Node, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return parseTokens(token.Tokenize(string(b))), nil
+}
+
+func firstNonSpace(tokens []token.Token) int {
+	for i, t := range tokens {
+		if t.Type != token.Text || strings.TrimSpace(t.Data) != "" {
+			return i
+		}
+	}
+	return len(tokens)
+}
+
+// rawElements have content that is not escaped.
+var rawElements = map[string]struct{}{
+	"iframe":   {},
+	"noembed":  {},
+	"noframes": {},
+	"script":   {},
+	"style":    {},
+	"xmp":      {},
+}
+
+// foreignElements start SVG and MathML content, where self-closing tags are honored.
+var foreignElements = map[string]struct{}{
+	"math": {},
+	"svg":  {},
+}
+
+// foreignName returns the name with its proper case in the foreign content started by the root element name.
+func foreignName(root, name string) string {
+	if root == "svg" {
+		if proper, ok := svgNames[name]; ok {
+			return proper
+		}
+	}
+	return name
+}
+
+// impliedEndTag of a start tag, which closes the outermost open element with one of the closes names,
+// if it's found before reaching an element with one of the scope names.
+type impliedEndTag struct {
+	closes, scope []string
+}
+
+// impliedEndTags by start tag name.
+// See https://html.spec.whatwg.org/multipage/syntax.html#optional-tags
+var impliedEndTags = map[string]impliedEndTag{}
+
+func init() {
+	scope := []string{"applet", "button", "caption", "html", "marquee", "object", "table", "td", "template", "th"}
+	for _, name := range []string{"address", "article", "aside", "blockquote", "details", "dialog", "div", "dl",
+		"fieldset", "figcaption", "figure", "footer", "form", "h1", "h2", "h3", "h4", "h5", "h6", "header", "hgroup",
+		"hr", "main", "menu", "nav", "ol", "p", "pre", "search", "section", "table", "ul"} {
+		impliedEndTags[name] = impliedEndTag{[]string{"p"}, scope}
+	}
+
+	impliedEndTags["li"] = impliedEndTag{[]string{"li", "p"}, append([]string{"ol", "ul"}, scope...)}
+	for _, name := range []string{"dd", "dt"} {
+		impliedEndTags[name] = impliedEndTag{[]string{"dd", "dt", "p"}, append([]string{"dl"}, scope...)}
+	}
+	for _, name := range []string{"rp", "rt"} {
+		impliedEndTags[name] = impliedEndTag{[]string{"rp", "rt"}, []string{"ruby"}}
+	}
+	impliedEndTags["option"] = impliedEndTag{[]string{"option"}, []string{"datalist", "optgroup", "select"}}
+	impliedEndTags["optgroup"] = impliedEndTag{[]string{"optgroup", "option"}, []string{"select"}}
+
+	tableScope := []string{"html", "table", "template"}
+	for _, name := range []string{"tbody", "tfoot", "thead"} {
+		impliedEndTags[name] = impliedEndTag{[]string{"caption", "colgroup", "tbody", "td", "tfoot", "th", "thead", "tr"}, tableScope}
+	}
+	impliedEndTags["tr"] = impliedEndTag{[]string{"caption", "colgroup", "td", "th", "tr"}, append([]string{"tbody", "tfoot", "thead"}, tableScope...)}
+	for _, name := range []string{"td", "th"} {
+		impliedEndTags[name] = impliedEndTag{[]string{"td", "th"}, append([]string{"tr"}, tableScope...)}
+	}
+	for _, name := range []string{"caption", "colgroup"} {
+		impliedEndTags[name] = impliedEndTag{[]string{"caption", "colgroup"}, tableScope}
+	}
+}
+
+// openElement is an element being parsed.
+type openElement struct {
+	element *Element
+	// foreign is the name of the element starting the foreign content the element is in, if any.
+	foreign string
+}
+
+func parseTokens(tokens []token.Token) []Node {
+	root := &openElement{element: &Element{}}
+	stack := []*openElement{root}
+
+	// closeTo closes the element at index i in the stack and all elements in it.
+	closeTo := func(i int) {
+		stack = stack[:i]
+	}
+
+	for _, t := range tokens {
+		current := stack[len(stack)-1]
+		switch t.Type {
+		case token.Text:
+			if _, ok := rawElements[current.element.Name]; ok && current.foreign == "" {
+				current.element.Children = append(current.element.Children, Raw(t.Data))
+			} else {
+				current.element.Children = append(current.element.Children, Text(stdhtml.UnescapeString(t.Data)))
+			}
+
+		case token.Comment, token.Doctype:
+			current.element.Children = append(current.element.Children, Raw(t.Raw))
+
+		case token.StartTag:
+			name := t.Name()
+			foreign := current.foreign
+			if _, ok := foreignElements[name]; ok && foreign == "" {
+				foreign = name
+			}
+			name = foreignName(foreign, name)
+
+			if implied, ok := impliedEndTags[name]; ok && foreign == "" {
+				outermost := -1
+				for i := len(stack) - 1; i > 0; i-- {
+					if containsString(implied.scope, stack[i].element.Name) {
+						break
+					}
+					if containsString(implied.closes, stack[i].element.Name) {
+						outermost = i
+					}
+				}
+				if outermost > 0 {
+					closeTo(outermost)
+					current = stack[len(stack)-1]
+				}
+			}
+
+			e := &Element{Name: name}
+			for _, a := range t.Attrs {
+				attrName := foreignName(foreign, strings.ToLower(a.Name))
+				if a.HasValue {
+					e.Children = append(e.Children, Attr(attrName, stdhtml.UnescapeString(a.Value)))
+				} else {
+					e.Children = append(e.Children, Attr(attrName))
+				}
+			}
+			current.element.Children = append(current.element.Children, e)
+
+			if foreign != "" && t.SelfClosing || foreign == "" && isVoidElement(name) {
+				continue
+			}
+			stack = append(stack, &openElement{element: e, foreign: foreign})
+
+		case token.EndTag:
+			for i := len(stack) - 1; i > 0; i-- {
+				if strings.EqualFold(stack[i].element.Name, t.Data) {
+					closeTo(i)
+					break
+				}
+			}
+		}
+	}
+
+	return root.element.Children
+}
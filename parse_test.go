@@ -0,0 +1,127 @@
+package html
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("parses a document with doctype", func(t *testing.T) {
+		n, err := Parse(strings.NewReader("<!DOCTYPE html>\n<html lang=en>\n<head><title>Hat</title></head>\n<body><p>Party</p></body>\n</html>\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := n.(DoctypeNode); !ok {
+			t.Fatalf("expected DoctypeNode but got %T", n)
+		}
+		Equal(t, "<!doctype html><html lang=\"en\">\n<head><title>Hat</title></head>\n<body><p>Party</p></body>\n</html>", n)
+	})
+
+	t.Run("moves nodes outside the html element into it", func(t *testing.T) {
+		n, err := Parse(strings.NewReader("<!-- hat --><html><body></body></html><!-- party -->"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		Equal(t, "<html><!-- hat --><body></body><!-- party --></html>", n)
+	})
+
+	t.Run("wraps several nodes without an html element in one", func(t *testing.T) {
+		n, err := Parse(strings.NewReader("<!doctype html><title>Hat</title><p>Party"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		Equal(t, "<!doctype html><html><title>Hat</title><p>Party</p></html>", n)
+	})
+
+	t.Run("returns read errors", func(t *testing.T) {
+		_, err := Parse(&erroringReader{})
+		Error(t, err)
+	})
+}
+
+type erroringReader struct{}
+
+func (r *erroringReader) Read([]byte) (int, error) {
+	return 0, errors.New("don't want to")
+}
+
+func TestParseFragment(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"elements, attributes and text", `<div class="hat" hidden><a href="/hats?a=1&amp;b=2">Hats &amp; caps</a></div>`,
+			`<div class="hat" hidden><a href="/hats?a=1&amp;b=2">Hats &amp; caps</a></div>`},
+		{"lower-cases names", `<DIV Class="hat">Hat</DIV>`, `<div class="hat">Hat</div>`},
+		{"decodes entities", `<p title="&quot;hat&quot;">&lt;party&gt; &copy; &#x41;&#66;</p>`,
+			`<p title="&#34;hat&#34;">&lt;party&gt; © AB</p>`},
+		{"void elements", `<p>a<br>b<img src="hat.png"></img><input/>c</p>`, `<p>a<br>b<img src="hat.png"><input>c</p>`},
+		{"raw text elements", `<script>if (a < b && c) { x("</p>") }</script><style>a > b {}</style>`,
+			`<script>if (a < b && c) { x("</p>") }</script><style>a > b {}</style>`},
+		{"escapable raw text elements", `<textarea><p>&amp;</textarea><title>a &lt; b</title>`,
+			`<textarea>&lt;p&gt;&amp;</textarea><title>a &lt; b</title>`},
+		{"comments", `<div><!-- hat --></div>`, `<div><!-- hat --></div>`},
+		{"implied end tags in lists", `<ul><li>a<li>b<ul><li>c</ul><li>d</ul>`,
+			`<ul><li>a</li><li>b<ul><li>c</li></ul></li><li>d</li></ul>`},
+		{"implied end tags in paragraphs", `<p>a<p>b<div>c</div><p>d<span>e</span>`,
+			`<p>a</p><p>b</p><div>c</div><p>d<span>e</span></p>`},
+		{"implied end tags in definition lists", `<dl><dt>a<dd>b<dt>c<dd>d</dl>`,
+			`<dl><dt>a</dt><dd>b</dd><dt>c</dt><dd>d</dd></dl>`},
+		{"implied end tags in tables", `<table><thead><tr><th>a<th>b<tbody><tr><td>c<td>d<tr><td>e</table>`,
+			`<table><thead><tr><th>a</th><th>b</th></tr></thead><tbody><tr><td>c</td><td>d</td></tr><tr><td>e</td></tr></tbody></table>`},
+		{"implied end tags in selects", `<select><optgroup><option>a<option>b<optgroup><option>c</select>`,
+			`<select><optgroup><option>a</option><option>b</option></optgroup><optgroup><option>c</option></optgroup></select>`},
+		{"paragraphs in table cells", `<table><tr><td><p>a<td>b</table>`, `<table><tr><td><p>a</p></td><td>b</td></tr></table>`},
+		{"ignores unmatched end tags", `<div>a</span></div></div>b`, `<div>a</div>b`},
+		{"closes open elements at the end", `<div><p>a`, `<div><p>a</p></div>`},
+		{"adjusts case of svg names", `<SVG VIEWBOX="0 0 1 1"><LinearGradient gradientunits="userSpaceOnUse"/></SVG><Div ClipPath="x"></Div>`,
+			`<svg viewBox="0 0 1 1"><linearGradient gradientUnits="userSpaceOnUse"></linearGradient></svg><div clippath="x"></div>`},
+		{"keeps case and self-closing tags in svg", `<svg viewBox="0 0 24 24"><clipPath id="c"/><path d="M0 0"/></svg><p/>x`,
+			`<svg viewBox="0 0 24 24"><clipPath id="c"></clipPath><path d="M0 0"></path></svg><p>x</p>`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nodes, err := ParseFragment(strings.NewReader(test.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			Equal(t, "<div>"+test.expected+"</div>", El("div", nodes...))
+		})
+	}
+
+	t.Run("builds inspectable nodes", func(t *testing.T) {
+		nodes, err := ParseFragment(strings.NewReader(`<a href="/" class="is-active">Home</a>`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(nodes) != 1 {
+			t.Fatal(nodes)
+		}
+		e := nodes[0].(*Element)
+		if href, _ := e.GetAttribute("href"); href != "/" {
+			t.Fatal(href)
+		}
+		if text, ok := e.ChildNodes()[0].(TextNode); !ok || text != "Home" {
+			t.Fatal(e.ChildNodes())
+		}
+		if Query(Group(nodes), "a.is-active") != e {
+			t.FailNow()
+		}
+	})
+}
+
+func ExampleParseFragment() {
+	nodes, _ := ParseFragment(strings.NewReader(`<p>Party <a href="/hats">hats</a>`))
+	e := Transform(Div(nodes...), func(n Node) Node {
+		if e, ok := n.(*Element); ok && e.Name == "a" {
+			e.SetAttribute("class", "link")
+		}
+		return n
+	})
+	_ = e.Render(os.Stdout)
+	// Output: <div><p>Party <a href="/hats" class="link">hats</a></p></div>
+}
@@ -0,0 +1,596 @@
+package html
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Query returns the first Element in the tree starting at n that matches the CSS selector,
+// or nil if there is none. The tree is searched in document order, and n itself is included.
+// Query panics if the selector is invalid, see CompileSelector for what's supported.
+//
+// Only Elements created with El or a helper are matched. Trees are searched like in Walk,
+// so Elements inside Nodes that are only known at render time, such as from FromContext, are not found.
+func Query(n Node, selector string) *Element {
+	return MustCompileSelector(selector).Query(n)
+}
+
+// QueryAll returns all Elements in the tree starting at n that match the CSS selector, in document order.
+// See Query.
+func QueryAll(n Node, selector string) []*Element {
+	return MustCompileSelector(selector).QueryAll(n)
+}
+
+// Selector is a compiled CSS selector, that can be used to query Node trees.
+type Selector struct {
+	source    string
+	selectors []complexSelector
+}
+
+// CompileSelector parses a CSS selector.
+// Supported are selector lists separated by ",", type selectors like "div" and the universal selector "*",
+// "#id", ".class", attribute selectors with the operators "=", "~=", "|=", "^=", "$=", and "*=" (with an
+// optional "i" flag for case-insensitive values), the descendant, child (">"), next-sibling ("+"),
+// and subsequent-sibling ("~") combinators, and the pseudo-classes ":first-child", ":last-child",
+// ":only-child", ":nth-child(an+b)", and ":nth-last-child(an+b)".
+func CompileSelector(s string) (*Selector, error) {
+	p := selectorParser{s: s}
+	selectors, err := p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector %q: %w", s, err)
+	}
+	return &Selector{source: s, selectors: selectors}, nil
+}
+
+// MustCompileSelector is like CompileSelector, but panics if the selector is invalid.
+func MustCompileSelector(s string) *Selector {
+	sel, err := CompileSelector(s)
+	if err != nil {
+		panic(err)
+	}
+	return sel
+}
+
+// String returns the selector source.
+func (s *Selector) String() string {
+	return s.source
+}
+
+// Query returns the first Element in the tree starting at n that matches the Selector, or nil.
+// See the Query function.
+func (s *Selector) Query(n Node) *Element {
+	for _, e := range indexElements(n) {
+		if s.matches(e) {
+			return e.element
+		}
+	}
+	return nil
+}
+
+// QueryAll returns all Elements in the tree starting at n that match the Selector, in document order.
+// See the Query function.
+func (s *Selector) QueryAll(n Node) []*Element {
+	var result []*Element
+	for _, e := range indexElements(n) {
+		if s.matches(e) {
+			result = append(result, e.element)
+		}
+	}
+	return result
+}
+
+func (s *Selector) matches(e *indexedElement) bool {
+	for _, c := range s.selectors {
+		if c.matches(len(c.compounds)-1, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// indexedElement is an Element with what is needed to match selectors against it.
+type indexedElement struct {
+	element *Element
+	parent  *indexedElement
+	// siblings are the Elements with the same parent, including this one, and index is its position in them.
+	siblings []*indexedElement
+	index    int
+	attrs    []Attribute
+	indexed  bool
+}
+
+func (e *indexedElement) attributes() []Attribute {
+	if !e.indexed {
+		for _, a := range e.element.Attributes() {
+			e.attrs = append(e.attrs, attributeValues(a)...)
+		}
+		e.indexed = true
+	}
+	return e.attrs
+}
+
+func (e *indexedElement) attribute(name string) (string, bool) {
+	for _, a := range e.attributes() {
+		if strings.EqualFold(a.Name, name) {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// indexElements returns the Elements in the tree starting at n in document order.
+func indexElements(n Node) []*indexedElement {
+	var result []*indexedElement
+	var index func(elements []*Element, parent *indexedElement)
+	index = func(elements []*Element, parent *indexedElement) {
+		siblings := make([]*indexedElement, len(elements))
+		for i, e := range elements {
+			siblings[i] = &indexedElement{element: e, parent: parent, siblings: siblings, index: i}
+		}
+		for _, e := range siblings {
+			result = append(result, e)
+			index(childElements(e.element.ChildNodes()), e)
+		}
+	}
+	index(childElements([]Node{n}), nil)
+	return result
+}
+
+// childElements returns the Elements in nodes, looking through Nodes that are not Elements but have children,
+// such as Groups and Parallel.
+func childElements(nodes []Node) []*Element {
+	var result []*Element
+	for _, n := range nodes {
+		switch n := n.(type) {
+		case nil:
+		case *Element:
+			result = append(result, n)
+		case GroupNode:
+			result = append(result, childElements(n)...)
+		case parentNode:
+			result = append(result, childElements(n.childNodes())...)
+		}
+	}
+	return result
+}
+
+// complexSelector is compound selectors separated by combinators.
+// combinators[i] is between compounds[i] and compounds[i+1].
+type complexSelector struct {
+	compounds   []compoundSelector
+	combinators []byte
+}
+
+// matches returns whether e matches the complex selector up to and including compound i.
+func (c complexSelector) matches(i int, e *indexedElement) bool {
+	if !c.compounds[i].matches(e) {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+
+	switch c.combinators[i-1] {
+	case ' ':
+		for p := e.parent; p != nil; p = p.parent {
+			if c.matches(i-1, p) {
+				return true
+			}
+		}
+	case '>':
+		return e.parent != nil && c.matches(i-1, e.parent)
+	case '+':
+		return e.index > 0 && c.matches(i-1, e.siblings[e.index-1])
+	case '~':
+		for j := e.index - 1; j >= 0; j-- {
+			if c.matches(i-1, e.siblings[j]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type compoundSelector struct {
+	tag     string
+	classes []string
+	attrs   []attributeSelector
+	pseudos []pseudoClass
+}
+
+func (c compoundSelector) matches(e *indexedElement) bool {
+	if c.tag != "" && c.tag != "*" && !strings.EqualFold(c.tag, e.element.Name) {
+		return false
+	}
+
+	if len(c.classes) > 0 {
+		class, _ := e.attribute("class")
+		classes := strings.Fields(class)
+		for _, want := range c.classes {
+			if !containsString(classes, want) {
+				return false
+			}
+		}
+	}
+
+	for _, a := range c.attrs {
+		if !a.matches(e) {
+			return false
+		}
+	}
+
+	for _, p := range c.pseudos {
+		if !p.matches(e) {
+			return false
+		}
+	}
+	return true
+}
+
+type attributeSelector struct {
+	name       string
+	op         string
+	value      string
+	ignoreCase bool
+}
+
+func (a attributeSelector) matches(e *indexedElement) bool {
+	v, ok := e.attribute(a.name)
+	if !ok {
+		return false
+	}
+	want := a.value
+	if a.ignoreCase {
+		v, want = strings.ToLower(v), strings.ToLower(want)
+	}
+
+	switch a.op {
+	case "":
+		return true
+	case "=":
+		return v == want
+	case "~=":
+		return containsString(strings.Fields(v), want)
+	case "|=":
+		return v == want || strings.HasPrefix(v, want+"-")
+	case "^=":
+		return want != "" && strings.HasPrefix(v, want)
+	case "$=":
+		return want != "" && strings.HasSuffix(v, want)
+	case "*=":
+		return want != "" && strings.Contains(v, want)
+	}
+	return false
+}
+
+// pseudoClass matches elements at position a*n+b among their siblings, for some n >= 0, counting from 1.
+// If fromEnd is true, positions are counted from the last sibling.
+type pseudoClass struct {
+	a, b    int
+	fromEnd bool
+	only    bool
+}
+
+func (p pseudoClass) matches(e *indexedElement) bool {
+	if p.only {
+		return len(e.siblings) == 1
+	}
+	pos := e.index + 1
+	if p.fromEnd {
+		pos = len(e.siblings) - e.index
+	}
+	if p.a == 0 {
+		return pos == p.b
+	}
+	diff := pos - p.b
+	return diff%p.a == 0 && diff/p.a >= 0
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// selectorParser parses selectors, see CompileSelector.
+type selectorParser struct {
+	s   string
+	pos int
+}
+
+func (p *selectorParser) parse() ([]complexSelector, error) {
+	var selectors []complexSelector
+	for {
+		c, err := p.parseComplex()
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, c)
+
+		p.skipSpace()
+		if p.pos >= len(p.s) {
+			return selectors, nil
+		}
+		if p.s[p.pos] != ',' {
+			return nil, p.errorf("unexpected %q", p.s[p.pos])
+		}
+		p.pos++
+	}
+}
+
+func (p *selectorParser) parseComplex() (complexSelector, error) {
+	var c complexSelector
+	p.skipSpace()
+	for {
+		compound, err := p.parseCompound()
+		if err != nil {
+			return c, err
+		}
+		c.compounds = append(c.compounds, compound)
+
+		hadSpace := p.skipSpace()
+		if p.pos >= len(p.s) || p.s[p.pos] == ',' {
+			return c, nil
+		}
+
+		switch combinator := p.s[p.pos]; combinator {
+		case '>', '+', '~':
+			p.pos++
+			p.skipSpace()
+			c.combinators = append(c.combinators, combinator)
+		default:
+			if !hadSpace {
+				return c, p.errorf("unexpected %q", combinator)
+			}
+			c.combinators = append(c.combinators, ' ')
+		}
+	}
+}
+
+func (p *selectorParser) parseCompound() (compoundSelector, error) {
+	var c compoundSelector
+	start := p.pos
+
+	if p.pos < len(p.s) && p.s[p.pos] == '*' {
+		c.tag = "*"
+		p.pos++
+	} else if p.startsIdent() {
+		c.tag = p.parseIdent()
+	}
+
+	for p.pos < len(p.s) {
+		switch p.s[p.pos] {
+		case '#':
+			p.pos++
+			if !p.startsIdent() {
+				return c, p.errorf("expected id")
+			}
+			c.attrs = append(c.attrs, attributeSelector{name: "id", op: "=", value: p.parseIdent()})
+		case '.':
+			p.pos++
+			if !p.startsIdent() {
+				return c, p.errorf("expected class name")
+			}
+			c.classes = append(c.classes, p.parseIdent())
+		case '[':
+			a, err := p.parseAttribute()
+			if err != nil {
+				return c, err
+			}
+			c.attrs = append(c.attrs, a)
+		case ':':
+			pc, err := p.parsePseudoClass()
+			if err != nil {
+				return c, err
+			}
+			c.pseudos = append(c.pseudos, pc)
+		default:
+			if p.pos == start {
+				return c, p.errorf("expected selector")
+			}
+			return c, nil
+		}
+	}
+	if p.pos == start {
+		return c, p.errorf("expected selector")
+	}
+	return c, nil
+}
+
+func (p *selectorParser) parseAttribute() (attributeSelector, error) {
+	var a attributeSelector
+	p.pos++
+	p.skipSpace()
+	if !p.startsIdent() {
+		return a, p.errorf("expected attribute name")
+	}
+	a.name = p.parseIdent()
+	p.skipSpace()
+
+	if p.pos >= len(p.s) {
+		return a, p.errorf("expected ]")
+	}
+	if p.s[p.pos] == ']' {
+		p.pos++
+		return a, nil
+	}
+
+	for _, op := range []string{"=", "~=", "|=", "^=", "$=", "*="} {
+		if strings.HasPrefix(p.s[p.pos:], op) {
+			a.op = op
+			p.pos += len(op)
+			break
+		}
+	}
+	if a.op == "" {
+		return a, p.errorf("expected attribute operator")
+	}
+	p.skipSpace()
+
+	if p.pos >= len(p.s) {
+		return a, p.errorf("expected attribute value")
+	}
+	switch q := p.s[p.pos]; {
+	case q == '"' || q == '\'':
+		end := strings.IndexByte(p.s[p.pos+1:], q)
+		if end < 0 {
+			return a, p.errorf("unterminated string")
+		}
+		a.value = p.s[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+	case q == ']':
+		return a, p.errorf("expected attribute value")
+	default:
+		// Unquoted values are allowed to be more than identifiers, like in "[href^=/]".
+		start := p.pos
+		for p.pos < len(p.s) && !isSpace(p.s[p.pos]) && p.s[p.pos] != ']' {
+			p.pos++
+		}
+		a.value = p.s[start:p.pos]
+	}
+	p.skipSpace()
+
+	if p.pos < len(p.s) && (p.s[p.pos] == 'i' || p.s[p.pos] == 'I') {
+		a.ignoreCase = true
+		p.pos++
+		p.skipSpace()
+	}
+	if p.pos >= len(p.s) || p.s[p.pos] != ']' {
+		return a, p.errorf("expected ]")
+	}
+	p.pos++
+	return a, nil
+}
+
+func (p *selectorParser) parsePseudoClass() (pseudoClass, error) {
+	p.pos++
+	if !p.startsIdent() {
+		return pseudoClass{}, p.errorf("expected pseudo-class")
+	}
+	name := strings.ToLower(p.parseIdent())
+
+	switch name {
+	case "first-child":
+		return pseudoClass{b: 1}, nil
+	case "last-child":
+		return pseudoClass{b: 1, fromEnd: true}, nil
+	case "only-child":
+		return pseudoClass{only: true}, nil
+	case "nth-child", "nth-last-child":
+		if p.pos >= len(p.s) || p.s[p.pos] != '(' {
+			return pseudoClass{}, p.errorf("expected (")
+		}
+		end := strings.IndexByte(p.s[p.pos:], ')')
+		if end < 0 {
+			return pseudoClass{}, p.errorf("expected )")
+		}
+		a, b, err := parseNth(p.s[p.pos+1 : p.pos+end])
+		if err != nil {
+			return pseudoClass{}, p.errorf("%v", err)
+		}
+		p.pos += end + 1
+		return pseudoClass{a: a, b: b, fromEnd: name == "nth-last-child"}, nil
+	default:
+		return pseudoClass{}, p.errorf("unsupported pseudo-class %q", name)
+	}
+}
+
+// parseNth parses the an+b syntax, including "odd" and "even".
+func parseNth(s string) (int, int, error) {
+	s = strings.ToLower(strings.Join(strings.Fields(s), ""))
+	switch s {
+	case "odd":
+		return 2, 1, nil
+	case "even":
+		return 2, 0, nil
+	}
+
+	i := strings.IndexByte(s, 'n')
+	if i < 0 {
+		b, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid an+b %q", s)
+		}
+		return 0, b, nil
+	}
+
+	var a int
+	switch as := s[:i]; as {
+	case "", "+":
+		a = 1
+	case "-":
+		a = -1
+	default:
+		var err error
+		if a, err = strconv.Atoi(as); err != nil {
+			return 0, 0, fmt.Errorf("invalid an+b %q", s)
+		}
+	}
+
+	var b int
+	if bs := s[i+1:]; bs != "" {
+		if bs[0] != '+' && bs[0] != '-' {
+			return 0, 0, fmt.Errorf("invalid an+b %q", s)
+		}
+		var err error
+		if b, err = strconv.Atoi(bs); err != nil {
+			return 0, 0, fmt.Errorf("invalid an+b %q", s)
+		}
+	}
+	return a, b, nil
+}
+
+func (p *selectorParser) startsIdent() bool {
+	if p.pos >= len(p.s) {
+		return false
+	}
+	c := p.s[p.pos]
+	if c == '-' && p.pos+1 < len(p.s) {
+		c = p.s[p.pos+1]
+	}
+	return isIdentStart(c) || c == '\\'
+}
+
+// parseIdent parses an identifier, with backslash escapes like in "md\:flex".
+func (p *selectorParser) parseIdent() string {
+	var b strings.Builder
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		switch {
+		case c == '\\' && p.pos+1 < len(p.s):
+			b.WriteByte(p.s[p.pos+1])
+			p.pos += 2
+		case isIdentStart(c) || isDigit(c) || c == '-':
+			b.WriteByte(c)
+			p.pos++
+		default:
+			return b.String()
+		}
+	}
+	return b.String()
+}
+
+// skipSpace and return whether there was any.
+func (p *selectorParser) skipSpace() bool {
+	start := p.pos
+	for p.pos < len(p.s) && isSpace(p.s[p.pos]) {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+func (p *selectorParser) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("%v at position %v", fmt.Sprintf(format, a...), p.pos)
+}
+
+func isIdentStart(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_' || c >= 0x80
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
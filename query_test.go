@@ -0,0 +1,134 @@
+package html
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	page := Doctype(HTML(Body(
+		Nav(ID("main-nav"),
+			Ul(
+				Li(A(Href("/"), Text("Home"))),
+				Li(A(Href("/hats"), Classes{"link": true, "is-active": true}, Text("Hats"))),
+				Li(A(Href("https://example.com"), Class("link external"), Text("Example"))),
+			),
+		),
+		Main(
+			Group([]Node{H1(Text("Hats")), P(Class("lead"), Text("Party"))}),
+			Parallel(Ul(Li(Text("a")), Li(Text("b")), Li(Text("c")), Li(Text("d")))),
+			Input(Type("checkbox"), Disabled(), DataAttr("size", "xl-2")),
+		),
+	)))
+
+	tests := []struct {
+		selector string
+		expected string
+	}{
+		{"nav ul > li a.is-active", "a(Hats)"},
+		{"a", "a(Home) a(Hats) a(Example)"},
+		{"#main-nav a.link", "a(Hats) a(Example)"},
+		{".link.external", "a(Example)"},
+		{"[href^=/]", "a(Home) a(Hats)"},
+		{`a[href$=".com"]`, "a(Example)"},
+		{"a[href*=hat]", "a(Hats)"},
+		{"a[class~=external]", "a(Example)"},
+		{"[data-size|=xl]", "input"},
+		{"input[disabled]", "input"},
+		{"[TYPE=CHECKBOX i]", "input"},
+		{"nav > a", ""},
+		{"body > * > ul > li:nth-child(2)", "li li(b)"},
+		{"main li:nth-child(odd)", "li(a) li(c)"},
+		{"main li:nth-child(-n+2)", "li(a) li(b)"},
+		{"main li:nth-last-child(1)", "li(d)"},
+		{"li:first-child a", "a(Home)"},
+		{"main li:last-child", "li(d)"},
+		{"ul:only-child", "ul"},
+		{"h1 + p", "p(Party)"},
+		{"h1 ~ input", "input"},
+		{"p ~ h1", ""},
+		{"h1, .lead", "h1(Hats) p(Party)"},
+		{"*:first-child", "html body nav ul li a(Home) a(Hats) a(Example) h1(Hats) li(a)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.selector, func(t *testing.T) {
+			if actual := describeElements(QueryAll(page, test.selector)); actual != test.expected {
+				t.Fatalf("expected %v but got %v", test.expected, actual)
+			}
+		})
+	}
+
+	t.Run("returns the first match", func(t *testing.T) {
+		if e := Query(page, "main li"); describeElements([]*Element{e}) != "li(a)" {
+			t.Fatal(e)
+		}
+	})
+
+	t.Run("returns nil for no match", func(t *testing.T) {
+		if e := Query(page, "table"); e != nil {
+			t.Fatal(e)
+		}
+	})
+
+	t.Run("includes the given element", func(t *testing.T) {
+		e := Div(Class("hat"))
+		if Query(e, ".hat") != e {
+			t.FailNow()
+		}
+	})
+
+	t.Run("panics on invalid selectors", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.FailNow()
+			}
+		}()
+		Query(page, "a[href")
+	})
+}
+
+func TestCompileSelector(t *testing.T) {
+	t.Run("returns errors for invalid selectors", func(t *testing.T) {
+		for _, s := range []string{"", "a,", "a >", "> a", "a[", "a[=b]", "a[b=]", `a[b="c]`, ".", "#", "a:hover", "a:nth-child(x)", "a!"} {
+			if _, err := CompileSelector(s); err == nil {
+				t.Errorf("expected error for %q", s)
+			}
+		}
+	})
+
+	t.Run("supports escapes in identifiers", func(t *testing.T) {
+		sel := MustCompileSelector(`.md\:flex`)
+		if sel.Query(Div(Class("md:flex"))) == nil {
+			t.FailNow()
+		}
+	})
+}
+
+// describeElements like "a(Home) ul", with the text child of each element in parentheses.
+func describeElements(elements []*Element) string {
+	var descriptions []string
+	for _, e := range elements {
+		d := e.Name
+		for _, c := range e.ChildNodes() {
+			if text, ok := c.(TextNode); ok {
+				d += "(" + string(text) + ")"
+			}
+		}
+		descriptions = append(descriptions, d)
+	}
+	return strings.Join(descriptions, " ")
+}
+
+func ExampleQuery() {
+	page := Nav(Ul(
+		Li(A(Href("/"), Text("Home"))),
+		Li(A(Href("/hats"), Class("is-active"), Text("Hats"))),
+	))
+
+	active := Query(page, "nav ul > li a.is-active")
+	href, _ := active.GetAttribute("href")
+	fmt.Println(href)
+	// Output: /hats
+}
@@ -0,0 +1,157 @@
+// Package snapshot provides golden file testing for Nodes and HTTP responses.
+//
+// Snapshots are stored in the testdata directory of the package under test, in a file named after the test,
+// like testdata/TestPage/with_user.golden for the subtest "with user" of TestPage.
+// Run the tests of a package that uses snapshots with the -update flag to create or update them:
+//
+//	go test ./components -update
+//
+// The package registers -update, unless a package imported before it already has, whose flag is then used.
+// Test packages with golden files of their own can read it with flag.Lookup("update") instead of defining it,
+// which would panic with "flag redefined". The -snapshot.update flag updates only snapshots, and is always registered.
+package snapshot
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/melias122/html"
+	"github.com/melias122/html/internal/diff"
+)
+
+var update = flag.Bool("snapshot.update", false, "update snapshot files in testdata")
+
+func init() {
+	if flag.Lookup("update") == nil {
+		flag.Bool("update", false, "update snapshot and golden files in testdata")
+	}
+}
+
+// updating returns whether snapshots are updated, with -update or -snapshot.update.
+func updating() bool {
+	if *update {
+		return true
+	}
+	if f := flag.Lookup("update"); f != nil {
+		if g, ok := f.Value.(flag.Getter); ok {
+			v, _ := g.Get().(bool)
+			return v
+		}
+	}
+	return false
+}
+
+// dir where snapshots are stored, relative to the package under test.
+var dir = "testdata"
+
+// T is the part of testing.TB used by the helpers in this package.
+type T interface {
+	Helper()
+	Name() string
+	Fatalf(format string, args ...interface{})
+}
+
+// Match the Node, rendered with html.Indent for readability, against the snapshot for the test.
+// It fails with a diff if they differ, or if there is no snapshot yet.
+func Match(t T, n html.Node) {
+	t.Helper()
+
+	var b strings.Builder
+	if err := html.Indent(n, "  ").Render(&b); err != nil {
+		t.Fatalf("error rendering node: %v", err)
+		return
+	}
+	match(t, b.String()+"\n")
+}
+
+// MatchResponse matches the status code, headers, and body of a recorded response against the snapshot for the test.
+// Headers are sorted by name. HTML bodies, by Content-Type header or detected content type, are rendered with html.Indent for readability.
+func MatchResponse(t T, r *httptest.ResponseRecorder) {
+	t.Helper()
+
+	res := r.Result()
+	var b strings.Builder
+	fmt.Fprintf(&b, "%v %v\n", res.Proto, res.Status)
+
+	names := make([]string, 0, len(res.Header))
+	for name := range res.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, v := range res.Header[name] {
+			fmt.Fprintf(&b, "%v: %v\n", name, v)
+		}
+	}
+	b.WriteString("\n")
+
+	body := r.Body.String()
+	// Like net/http, detect the content type if it's not set, as Adapt doesn't set it.
+	contentType := res.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType([]byte(body))
+	}
+	if strings.HasPrefix(contentType, "text/html") {
+		var indented strings.Builder
+		if err := html.Indent(html.Raw(body), "  ").Render(&indented); err != nil {
+			t.Fatalf("error rendering body: %v", err)
+			return
+		}
+		body = indented.String()
+	}
+	b.WriteString(body)
+	if !strings.HasSuffix(body, "\n") {
+		b.WriteString("\n")
+	}
+	match(t, b.String())
+}
+
+func match(t T, actual string) {
+	t.Helper()
+
+	path := filepath.Join(dir, fileName(t.Name())+".golden")
+	if updating() {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("error creating snapshot directory: %v", err)
+			return
+		}
+		if err := os.WriteFile(path, []byte(actual), 0644); err != nil {
+			t.Fatalf("error writing snapshot: %v", err)
+		}
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			t.Fatalf("snapshot %v does not exist, run the test with -update to create it", path)
+			return
+		}
+		t.Fatalf("error reading snapshot: %v", err)
+		return
+	}
+
+	if d := diff.Lines(strings.Split(string(expected), "\n"), strings.Split(actual, "\n")); d != "" {
+		t.Fatalf("snapshot %v does not match (-snapshot +actual), run the test with -update to update it:\n%v", path, d)
+	}
+}
+
+// fileName for a test name, which keeps the subtest separators as directories
+// and replaces characters that are not safe in file names.
+func fileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9', r == '-':
+			return r
+		case r == '/':
+			return filepath.Separator
+		}
+		return '_'
+	}, name)
+}
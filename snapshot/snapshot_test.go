@@ -0,0 +1,144 @@
+package snapshot
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/melias122/html"
+	ghttp "github.com/melias122/html/http"
+)
+
+// fakeT records failures instead of failing the test.
+type fakeT struct {
+	name    string
+	failed  bool
+	message string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Name() string {
+	return t.name
+}
+
+func (t *fakeT) Fatalf(format string, args ...interface{}) {
+	t.failed = true
+	t.message = fmt.Sprintf(format, args...)
+}
+
+func page(title string) html.Node {
+	return html.HTML5(html.HTML5Props{
+		Title: title,
+		Body:  []html.Node{html.Nav(html.Ul(html.Li(html.A(html.Href("/"), html.Text("Home"))))), html.H1(html.Text(title))},
+	})
+}
+
+func TestMatch(t *testing.T) {
+	t.Run("matches the snapshot in testdata", func(t *testing.T) {
+		Match(t, page("Hats"))
+	})
+
+	t.Run("fails with a diff if the snapshot differs", func(t *testing.T) {
+		if updating() {
+			t.Skip("updating snapshots")
+		}
+
+		ft := &fakeT{name: "TestMatch/matches_the_snapshot_in_testdata"}
+		Match(ft, page("Caps"))
+		if !ft.failed {
+			t.FailNow()
+		}
+		for _, s := range []string{"-     <title>Hats</title>\n+     <title>Caps</title>\n", "-     <h1>Hats</h1>\n+     <h1>Caps</h1>\n"} {
+			if !strings.Contains(ft.message, s) {
+				t.Fatalf("expected %q in %v", s, ft.message)
+			}
+		}
+	})
+
+	t.Run("fails if there is no snapshot", func(t *testing.T) {
+		if updating() {
+			t.Skip("updating snapshots")
+		}
+
+		ft := &fakeT{name: "TestMatch/missing"}
+		Match(ft, page("Hats"))
+		expected := "snapshot " + filepath.Join("testdata", "TestMatch", "missing.golden") + " does not exist, run the test with -update to create it"
+		if ft.message != expected {
+			t.Fatal(ft.message)
+		}
+	})
+
+	t.Run("writes the snapshot with -snapshot.update", func(t *testing.T) {
+		defer func(d string, u bool) { dir, *update = d, u }(dir, *update)
+		dir, *update = t.TempDir(), true
+
+		ft := &fakeT{name: "TestPage/with a:user"}
+		Match(ft, html.Div(html.P(html.Text("Hats"))))
+		if ft.failed {
+			t.Fatal(ft.message)
+		}
+
+		b, err := os.ReadFile(filepath.Join(dir, "TestPage", "with_a_user.golden"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != "<div>\n  <p>Hats</p>\n</div>\n" {
+			t.Fatal(string(b))
+		}
+
+		*update = false
+		Match(ft, html.Div(html.P(html.Text("Hats"))))
+		if ft.failed {
+			t.Fatal(ft.message)
+		}
+	})
+
+	t.Run("writes the snapshot with -update", func(t *testing.T) {
+		f := flag.Lookup("update")
+		if f == nil {
+			t.Fatal("no -update flag")
+		}
+		defer func(d, u string) { dir = d; _ = f.Value.Set(u) }(dir, f.Value.String())
+		dir = t.TempDir()
+		if err := f.Value.Set("true"); err != nil {
+			t.Fatal(err)
+		}
+
+		ft := &fakeT{name: "TestPage"}
+		Match(ft, html.Div())
+		if ft.failed {
+			t.Fatal(ft.message)
+		}
+		if _, err := os.Stat(filepath.Join(dir, "TestPage.golden")); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestMatchResponse(t *testing.T) {
+	t.Run("matches status code, sorted headers and indented body", func(t *testing.T) {
+		h := ghttp.Adapt(func(w http.ResponseWriter, r *http.Request) (html.Node, error) {
+			w.Header().Set("X-Hat", "party")
+			w.Header().Set("Cache-Control", "no-store")
+			w.WriteHeader(http.StatusTeapot)
+			return page("Hats"), nil
+		})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		MatchResponse(t, w)
+	})
+
+	t.Run("keeps non-HTML bodies as is", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.WriteString("<p>hat</p>")
+		MatchResponse(t, w)
+	})
+}
@@ -0,0 +1,925 @@
+// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package svg
+
+import "github.com/melias122/html"
+
+// Accumulate returns an accumulate attribute, used on animate, animateMotion, animateTransform, and set elements.
+func Accumulate(v string) html.Node {
+	return html.Attr("accumulate", v)
+}
+
+// Additive returns an additive attribute, used on animate, animateMotion, animateTransform, and set elements.
+func Additive(v string) html.Node {
+	return html.Attr("additive", v)
+}
+
+// AlignmentBaseline returns a global alignment-baseline attribute.
+func AlignmentBaseline(v string) html.Node {
+	return html.Attr("alignment-baseline", v)
+}
+
+// Amplitude returns an amplitude attribute, used on feFuncA, feFuncB, feFuncG, and feFuncR elements.
+func Amplitude(v string) html.Node {
+	return html.Attr("amplitude", v)
+}
+
+// AttributeName returns an attributeName attribute, used on animate, animateTransform, and set elements.
+func AttributeName(v string) html.Node {
+	return html.Attr("attributeName", v)
+}
+
+// Azimuth returns an azimuth attribute, used on feDistantLight elements.
+func Azimuth(v string) html.Node {
+	return html.Attr("azimuth", v)
+}
+
+// BaseFrequency returns a baseFrequency attribute, used on feTurbulence elements.
+func BaseFrequency(v string) html.Node {
+	return html.Attr("baseFrequency", v)
+}
+
+// BaselineShift returns a global baseline-shift attribute.
+func BaselineShift(v string) html.Node {
+	return html.Attr("baseline-shift", v)
+}
+
+// Begin returns a begin attribute, used on animate, animateMotion, animateTransform, and set elements.
+func Begin(v string) html.Node {
+	return html.Attr("begin", v)
+}
+
+// Bias returns a bias attribute, used on feConvolveMatrix elements.
+func Bias(v string) html.Node {
+	return html.Attr("bias", v)
+}
+
+// By returns a by attribute, used on animate, animateMotion, and animateTransform elements.
+func By(v string) html.Node {
+	return html.Attr("by", v)
+}
+
+// CalcMode returns a calcMode attribute, used on animate, animateMotion, and animateTransform elements.
+func CalcMode(v string) html.Node {
+	return html.Attr("calcMode", v)
+}
+
+// Class returns a global class attribute.
+func Class(v string) html.Node {
+	return html.Attr("class", v)
+}
+
+// ClipPathAttr returns a global clip-path attribute.
+func ClipPathAttr(v string) html.Node {
+	return html.Attr("clip-path", v)
+}
+
+// ClipRule returns a global clip-rule attribute.
+func ClipRule(v string) html.Node {
+	return html.Attr("clip-rule", v)
+}
+
+// ClipPathUnits returns a clipPathUnits attribute, used on clipPath elements.
+func ClipPathUnits(v string) html.Node {
+	return html.Attr("clipPathUnits", v)
+}
+
+// Color returns a global color attribute.
+func Color(v string) html.Node {
+	return html.Attr("color", v)
+}
+
+// ColorInterpolation returns a global color-interpolation attribute.
+func ColorInterpolation(v string) html.Node {
+	return html.Attr("color-interpolation", v)
+}
+
+// ColorInterpolationFilters returns a global color-interpolation-filters attribute.
+func ColorInterpolationFilters(v string) html.Node {
+	return html.Attr("color-interpolation-filters", v)
+}
+
+// CrossOrigin returns a crossorigin attribute, used on image and script elements.
+func CrossOrigin(v string) html.Node {
+	return html.Attr("crossorigin", v)
+}
+
+// Cursor returns a global cursor attribute.
+func Cursor(v string) html.Node {
+	return html.Attr("cursor", v)
+}
+
+// Cx returns a cx attribute, used on circle, ellipse, and radialGradient elements.
+func Cx(v string) html.Node {
+	return html.Attr("cx", v)
+}
+
+// Cy returns a cy attribute, used on circle, ellipse, and radialGradient elements.
+func Cy(v string) html.Node {
+	return html.Attr("cy", v)
+}
+
+// D returns a d attribute, used on path elements.
+func D(v string) html.Node {
+	return html.Attr("d", v)
+}
+
+// DiffuseConstant returns a diffuseConstant attribute, used on feDiffuseLighting elements.
+func DiffuseConstant(v string) html.Node {
+	return html.Attr("diffuseConstant", v)
+}
+
+// Direction returns a global direction attribute.
+func Direction(v string) html.Node {
+	return html.Attr("direction", v)
+}
+
+// Display returns a global display attribute.
+func Display(v string) html.Node {
+	return html.Attr("display", v)
+}
+
+// Divisor returns a divisor attribute, used on feConvolveMatrix elements.
+func Divisor(v string) html.Node {
+	return html.Attr("divisor", v)
+}
+
+// DominantBaseline returns a global dominant-baseline attribute.
+func DominantBaseline(v string) html.Node {
+	return html.Attr("dominant-baseline", v)
+}
+
+// Download returns a download attribute, used on a elements.
+func Download(v string) html.Node {
+	return html.Attr("download", v)
+}
+
+// Dur returns a dur attribute, used on animate, animateMotion, animateTransform, and set elements.
+func Dur(v string) html.Node {
+	return html.Attr("dur", v)
+}
+
+// Dx returns a dx attribute, used on feDropShadow, feOffset, text, and tspan elements.
+func Dx(v string) html.Node {
+	return html.Attr("dx", v)
+}
+
+// Dy returns a dy attribute, used on feDropShadow, feOffset, text, and tspan elements.
+func Dy(v string) html.Node {
+	return html.Attr("dy", v)
+}
+
+// EdgeMode returns an edgeMode attribute, used on feConvolveMatrix and feGaussianBlur elements.
+func EdgeMode(v string) html.Node {
+	return html.Attr("edgeMode", v)
+}
+
+// Elevation returns an elevation attribute, used on feDistantLight elements.
+func Elevation(v string) html.Node {
+	return html.Attr("elevation", v)
+}
+
+// End returns an end attribute, used on animate, animateMotion, animateTransform, and set elements.
+func End(v string) html.Node {
+	return html.Attr("end", v)
+}
+
+// Exponent returns an exponent attribute, used on feFuncA, feFuncB, feFuncG, and feFuncR elements.
+func Exponent(v string) html.Node {
+	return html.Attr("exponent", v)
+}
+
+// Fill returns a global fill attribute.
+func Fill(v string) html.Node {
+	return html.Attr("fill", v)
+}
+
+// FillOpacity returns a global fill-opacity attribute.
+func FillOpacity(v string) html.Node {
+	return html.Attr("fill-opacity", v)
+}
+
+// FillRule returns a global fill-rule attribute.
+func FillRule(v string) html.Node {
+	return html.Attr("fill-rule", v)
+}
+
+// FilterAttr returns a global filter attribute.
+func FilterAttr(v string) html.Node {
+	return html.Attr("filter", v)
+}
+
+// FilterUnits returns a filterUnits attribute, used on filter elements.
+func FilterUnits(v string) html.Node {
+	return html.Attr("filterUnits", v)
+}
+
+// FloodColor returns a global flood-color attribute.
+func FloodColor(v string) html.Node {
+	return html.Attr("flood-color", v)
+}
+
+// FloodOpacity returns a global flood-opacity attribute.
+func FloodOpacity(v string) html.Node {
+	return html.Attr("flood-opacity", v)
+}
+
+// FontFamily returns a global font-family attribute.
+func FontFamily(v string) html.Node {
+	return html.Attr("font-family", v)
+}
+
+// FontSize returns a global font-size attribute.
+func FontSize(v string) html.Node {
+	return html.Attr("font-size", v)
+}
+
+// FontSizeAdjust returns a global font-size-adjust attribute.
+func FontSizeAdjust(v string) html.Node {
+	return html.Attr("font-size-adjust", v)
+}
+
+// FontStretch returns a global font-stretch attribute.
+func FontStretch(v string) html.Node {
+	return html.Attr("font-stretch", v)
+}
+
+// FontStyle returns a global font-style attribute.
+func FontStyle(v string) html.Node {
+	return html.Attr("font-style", v)
+}
+
+// FontVariant returns a global font-variant attribute.
+func FontVariant(v string) html.Node {
+	return html.Attr("font-variant", v)
+}
+
+// FontWeight returns a global font-weight attribute.
+func FontWeight(v string) html.Node {
+	return html.Attr("font-weight", v)
+}
+
+// Fr returns a fr attribute, used on radialGradient elements.
+func Fr(v string) html.Node {
+	return html.Attr("fr", v)
+}
+
+// From returns a from attribute, used on animate, animateMotion, and animateTransform elements.
+func From(v string) html.Node {
+	return html.Attr("from", v)
+}
+
+// Fx returns a fx attribute, used on radialGradient elements.
+func Fx(v string) html.Node {
+	return html.Attr("fx", v)
+}
+
+// Fy returns a fy attribute, used on radialGradient elements.
+func Fy(v string) html.Node {
+	return html.Attr("fy", v)
+}
+
+// GradientTransform returns a gradientTransform attribute, used on linearGradient and radialGradient elements.
+func GradientTransform(v string) html.Node {
+	return html.Attr("gradientTransform", v)
+}
+
+// GradientUnits returns a gradientUnits attribute, used on linearGradient and radialGradient elements.
+func GradientUnits(v string) html.Node {
+	return html.Attr("gradientUnits", v)
+}
+
+// Height returns a height attribute, used on feBlend, feColorMatrix, feComponentTransfer, feComposite, feConvolveMatrix, feDiffuseLighting, feDisplacementMap, feDropShadow, feFlood, feGaussianBlur, feImage, feMerge, feMorphology, feOffset, feSpecularLighting, feTile, feTurbulence, filter, foreignObject, image, mask, pattern, rect, svg, symbol, and use elements.
+func Height(v string) html.Node {
+	return html.Attr("height", v)
+}
+
+// Href returns a href attribute, used on a, animate, animateMotion, animateTransform, feImage, image, linearGradient, mpath, pattern, radialGradient, script, set, textPath, and use elements.
+func Href(v string) html.Node {
+	return html.Attr("href", v)
+}
+
+// HrefLang returns a hreflang attribute, used on a elements.
+func HrefLang(v string) html.Node {
+	return html.Attr("hreflang", v)
+}
+
+// ID returns a global id attribute.
+func ID(v string) html.Node {
+	return html.Attr("id", v)
+}
+
+// ImageRendering returns a global image-rendering attribute.
+func ImageRendering(v string) html.Node {
+	return html.Attr("image-rendering", v)
+}
+
+// In returns an in attribute, used on feBlend, feColorMatrix, feComponentTransfer, feComposite, feConvolveMatrix, feDiffuseLighting, feDisplacementMap, feDropShadow, feGaussianBlur, feMergeNode, feMorphology, feOffset, feSpecularLighting, and feTile elements.
+func In(v string) html.Node {
+	return html.Attr("in", v)
+}
+
+// In2 returns an in2 attribute, used on feBlend, feComposite, and feDisplacementMap elements.
+func In2(v string) html.Node {
+	return html.Attr("in2", v)
+}
+
+// Intercept returns an intercept attribute, used on feFuncA, feFuncB, feFuncG, and feFuncR elements.
+func Intercept(v string) html.Node {
+	return html.Attr("intercept", v)
+}
+
+// K1 returns a k1 attribute, used on feComposite elements.
+func K1(v string) html.Node {
+	return html.Attr("k1", v)
+}
+
+// K2 returns a k2 attribute, used on feComposite elements.
+func K2(v string) html.Node {
+	return html.Attr("k2", v)
+}
+
+// K3 returns a k3 attribute, used on feComposite elements.
+func K3(v string) html.Node {
+	return html.Attr("k3", v)
+}
+
+// K4 returns a k4 attribute, used on feComposite elements.
+func K4(v string) html.Node {
+	return html.Attr("k4", v)
+}
+
+// KernelMatrix returns a kernelMatrix attribute, used on feConvolveMatrix elements.
+func KernelMatrix(v string) html.Node {
+	return html.Attr("kernelMatrix", v)
+}
+
+// KernelUnitLength returns a kernelUnitLength attribute, used on feConvolveMatrix, feDiffuseLighting, and feSpecularLighting elements.
+func KernelUnitLength(v string) html.Node {
+	return html.Attr("kernelUnitLength", v)
+}
+
+// KeyPoints returns a keyPoints attribute, used on animateMotion elements.
+func KeyPoints(v string) html.Node {
+	return html.Attr("keyPoints", v)
+}
+
+// KeySplines returns a keySplines attribute, used on animate, animateMotion, and animateTransform elements.
+func KeySplines(v string) html.Node {
+	return html.Attr("keySplines", v)
+}
+
+// KeyTimes returns a keyTimes attribute, used on animate, animateMotion, and animateTransform elements.
+func KeyTimes(v string) html.Node {
+	return html.Attr("keyTimes", v)
+}
+
+// Lang returns a global lang attribute.
+func Lang(v string) html.Node {
+	return html.Attr("lang", v)
+}
+
+// LengthAdjust returns a lengthAdjust attribute, used on text, textPath, and tspan elements.
+func LengthAdjust(v string) html.Node {
+	return html.Attr("lengthAdjust", v)
+}
+
+// LetterSpacing returns a global letter-spacing attribute.
+func LetterSpacing(v string) html.Node {
+	return html.Attr("letter-spacing", v)
+}
+
+// LightingColor returns a global lighting-color attribute.
+func LightingColor(v string) html.Node {
+	return html.Attr("lighting-color", v)
+}
+
+// LimitingConeAngle returns a limitingConeAngle attribute, used on feSpotLight elements.
+func LimitingConeAngle(v string) html.Node {
+	return html.Attr("limitingConeAngle", v)
+}
+
+// MarkerEnd returns a global marker-end attribute.
+func MarkerEnd(v string) html.Node {
+	return html.Attr("marker-end", v)
+}
+
+// MarkerMid returns a global marker-mid attribute.
+func MarkerMid(v string) html.Node {
+	return html.Attr("marker-mid", v)
+}
+
+// MarkerStart returns a global marker-start attribute.
+func MarkerStart(v string) html.Node {
+	return html.Attr("marker-start", v)
+}
+
+// MarkerHeight returns a markerHeight attribute, used on marker elements.
+func MarkerHeight(v string) html.Node {
+	return html.Attr("markerHeight", v)
+}
+
+// MarkerUnits returns a markerUnits attribute, used on marker elements.
+func MarkerUnits(v string) html.Node {
+	return html.Attr("markerUnits", v)
+}
+
+// MarkerWidth returns a markerWidth attribute, used on marker elements.
+func MarkerWidth(v string) html.Node {
+	return html.Attr("markerWidth", v)
+}
+
+// MaskAttr returns a global mask attribute.
+func MaskAttr(v string) html.Node {
+	return html.Attr("mask", v)
+}
+
+// MaskType returns a global mask-type attribute.
+func MaskType(v string) html.Node {
+	return html.Attr("mask-type", v)
+}
+
+// MaskContentUnits returns a maskContentUnits attribute, used on mask elements.
+func MaskContentUnits(v string) html.Node {
+	return html.Attr("maskContentUnits", v)
+}
+
+// MaskUnits returns a maskUnits attribute, used on mask elements.
+func MaskUnits(v string) html.Node {
+	return html.Attr("maskUnits", v)
+}
+
+// Max returns a max attribute, used on animate, animateMotion, animateTransform, and set elements.
+func Max(v string) html.Node {
+	return html.Attr("max", v)
+}
+
+// Media returns a media attribute, used on style elements.
+func Media(v string) html.Node {
+	return html.Attr("media", v)
+}
+
+// Method returns a method attribute, used on textPath elements.
+func Method(v string) html.Node {
+	return html.Attr("method", v)
+}
+
+// Min returns a min attribute, used on animate, animateMotion, animateTransform, and set elements.
+func Min(v string) html.Node {
+	return html.Attr("min", v)
+}
+
+// Mode returns a mode attribute, used on feBlend elements.
+func Mode(v string) html.Node {
+	return html.Attr("mode", v)
+}
+
+// NumOctaves returns a numOctaves attribute, used on feTurbulence elements.
+func NumOctaves(v string) html.Node {
+	return html.Attr("numOctaves", v)
+}
+
+// Offset returns an offset attribute, used on stop elements.
+func Offset(v string) html.Node {
+	return html.Attr("offset", v)
+}
+
+// Opacity returns a global opacity attribute.
+func Opacity(v string) html.Node {
+	return html.Attr("opacity", v)
+}
+
+// Operator returns an operator attribute, used on feComposite and feMorphology elements.
+func Operator(v string) html.Node {
+	return html.Attr("operator", v)
+}
+
+// Order returns an order attribute, used on feConvolveMatrix elements.
+func Order(v string) html.Node {
+	return html.Attr("order", v)
+}
+
+// Orient returns an orient attribute, used on marker elements.
+func Orient(v string) html.Node {
+	return html.Attr("orient", v)
+}
+
+// Overflow returns a global overflow attribute.
+func Overflow(v string) html.Node {
+	return html.Attr("overflow", v)
+}
+
+// PaintOrder returns a global paint-order attribute.
+func PaintOrder(v string) html.Node {
+	return html.Attr("paint-order", v)
+}
+
+// PathAttr returns a path attribute, used on animateMotion and textPath elements.
+func PathAttr(v string) html.Node {
+	return html.Attr("path", v)
+}
+
+// PathLength returns a pathLength attribute, used on circle, ellipse, line, path, polygon, polyline, and rect elements.
+func PathLength(v string) html.Node {
+	return html.Attr("pathLength", v)
+}
+
+// PatternContentUnits returns a patternContentUnits attribute, used on pattern elements.
+func PatternContentUnits(v string) html.Node {
+	return html.Attr("patternContentUnits", v)
+}
+
+// PatternTransform returns a patternTransform attribute, used on pattern elements.
+func PatternTransform(v string) html.Node {
+	return html.Attr("patternTransform", v)
+}
+
+// PatternUnits returns a patternUnits attribute, used on pattern elements.
+func PatternUnits(v string) html.Node {
+	return html.Attr("patternUnits", v)
+}
+
+// Ping returns a ping attribute, used on a elements.
+func Ping(v string) html.Node {
+	return html.Attr("ping", v)
+}
+
+// PointerEvents returns a global pointer-events attribute.
+func PointerEvents(v string) html.Node {
+	return html.Attr("pointer-events", v)
+}
+
+// Points returns a points attribute, used on polygon and polyline elements.
+func Points(v string) html.Node {
+	return html.Attr("points", v)
+}
+
+// PointsAtX returns a pointsAtX attribute, used on feSpotLight elements.
+func PointsAtX(v string) html.Node {
+	return html.Attr("pointsAtX", v)
+}
+
+// PointsAtY returns a pointsAtY attribute, used on feSpotLight elements.
+func PointsAtY(v string) html.Node {
+	return html.Attr("pointsAtY", v)
+}
+
+// PointsAtZ returns a pointsAtZ attribute, used on feSpotLight elements.
+func PointsAtZ(v string) html.Node {
+	return html.Attr("pointsAtZ", v)
+}
+
+// PreserveAlpha returns a preserveAlpha attribute, used on feConvolveMatrix elements.
+func PreserveAlpha(v string) html.Node {
+	return html.Attr("preserveAlpha", v)
+}
+
+// PreserveAspectRatio returns a preserveAspectRatio attribute, used on feImage, image, marker, pattern, svg, symbol, and view elements.
+func PreserveAspectRatio(v string) html.Node {
+	return html.Attr("preserveAspectRatio", v)
+}
+
+// PrimitiveUnits returns a primitiveUnits attribute, used on filter elements.
+func PrimitiveUnits(v string) html.Node {
+	return html.Attr("primitiveUnits", v)
+}
+
+// R returns a r attribute, used on circle and radialGradient elements.
+func R(v string) html.Node {
+	return html.Attr("r", v)
+}
+
+// Radius returns a radius attribute, used on feMorphology elements.
+func Radius(v string) html.Node {
+	return html.Attr("radius", v)
+}
+
+// ReferrerPolicy returns a referrerpolicy attribute, used on a elements.
+func ReferrerPolicy(v string) html.Node {
+	return html.Attr("referrerpolicy", v)
+}
+
+// RefX returns a refX attribute, used on marker and symbol elements.
+func RefX(v string) html.Node {
+	return html.Attr("refX", v)
+}
+
+// RefY returns a refY attribute, used on marker and symbol elements.
+func RefY(v string) html.Node {
+	return html.Attr("refY", v)
+}
+
+// Rel returns a rel attribute, used on a elements.
+func Rel(v string) html.Node {
+	return html.Attr("rel", v)
+}
+
+// RepeatCount returns a repeatCount attribute, used on animate, animateMotion, animateTransform, and set elements.
+func RepeatCount(v string) html.Node {
+	return html.Attr("repeatCount", v)
+}
+
+// RepeatDur returns a repeatDur attribute, used on animate, animateMotion, animateTransform, and set elements.
+func RepeatDur(v string) html.Node {
+	return html.Attr("repeatDur", v)
+}
+
+// Restart returns a restart attribute, used on animate, animateMotion, animateTransform, and set elements.
+func Restart(v string) html.Node {
+	return html.Attr("restart", v)
+}
+
+// Result returns a result attribute, used on feBlend, feColorMatrix, feComponentTransfer, feComposite, feConvolveMatrix, feDiffuseLighting, feDisplacementMap, feDropShadow, feFlood, feGaussianBlur, feImage, feMerge, feMorphology, feOffset, feSpecularLighting, feTile, and feTurbulence elements.
+func Result(v string) html.Node {
+	return html.Attr("result", v)
+}
+
+// Rotate returns a rotate attribute, used on animateMotion, text, and tspan elements.
+func Rotate(v string) html.Node {
+	return html.Attr("rotate", v)
+}
+
+// Rx returns a rx attribute, used on ellipse and rect elements.
+func Rx(v string) html.Node {
+	return html.Attr("rx", v)
+}
+
+// Ry returns a ry attribute, used on ellipse and rect elements.
+func Ry(v string) html.Node {
+	return html.Attr("ry", v)
+}
+
+// Scale returns a scale attribute, used on feDisplacementMap elements.
+func Scale(v string) html.Node {
+	return html.Attr("scale", v)
+}
+
+// Seed returns a seed attribute, used on feTurbulence elements.
+func Seed(v string) html.Node {
+	return html.Attr("seed", v)
+}
+
+// ShapeRendering returns a global shape-rendering attribute.
+func ShapeRendering(v string) html.Node {
+	return html.Attr("shape-rendering", v)
+}
+
+// Side returns a side attribute, used on textPath elements.
+func Side(v string) html.Node {
+	return html.Attr("side", v)
+}
+
+// Slope returns a slope attribute, used on feFuncA, feFuncB, feFuncG, and feFuncR elements.
+func Slope(v string) html.Node {
+	return html.Attr("slope", v)
+}
+
+// Spacing returns a spacing attribute, used on textPath elements.
+func Spacing(v string) html.Node {
+	return html.Attr("spacing", v)
+}
+
+// SpecularConstant returns a specularConstant attribute, used on feSpecularLighting elements.
+func SpecularConstant(v string) html.Node {
+	return html.Attr("specularConstant", v)
+}
+
+// SpecularExponent returns a specularExponent attribute, used on feSpecularLighting and feSpotLight elements.
+func SpecularExponent(v string) html.Node {
+	return html.Attr("specularExponent", v)
+}
+
+// SpreadMethod returns a spreadMethod attribute, used on linearGradient and radialGradient elements.
+func SpreadMethod(v string) html.Node {
+	return html.Attr("spreadMethod", v)
+}
+
+// StartOffset returns a startOffset attribute, used on textPath elements.
+func StartOffset(v string) html.Node {
+	return html.Attr("startOffset", v)
+}
+
+// StdDeviation returns a stdDeviation attribute, used on feDropShadow and feGaussianBlur elements.
+func StdDeviation(v string) html.Node {
+	return html.Attr("stdDeviation", v)
+}
+
+// StitchTiles returns a stitchTiles attribute, used on feTurbulence elements.
+func StitchTiles(v string) html.Node {
+	return html.Attr("stitchTiles", v)
+}
+
+// StopColor returns a global stop-color attribute.
+func StopColor(v string) html.Node {
+	return html.Attr("stop-color", v)
+}
+
+// StopOpacity returns a global stop-opacity attribute.
+func StopOpacity(v string) html.Node {
+	return html.Attr("stop-opacity", v)
+}
+
+// Stroke returns a global stroke attribute.
+func Stroke(v string) html.Node {
+	return html.Attr("stroke", v)
+}
+
+// StrokeDasharray returns a global stroke-dasharray attribute.
+func StrokeDasharray(v string) html.Node {
+	return html.Attr("stroke-dasharray", v)
+}
+
+// StrokeDashoffset returns a global stroke-dashoffset attribute.
+func StrokeDashoffset(v string) html.Node {
+	return html.Attr("stroke-dashoffset", v)
+}
+
+// StrokeLinecap returns a global stroke-linecap attribute.
+func StrokeLinecap(v string) html.Node {
+	return html.Attr("stroke-linecap", v)
+}
+
+// StrokeLinejoin returns a global stroke-linejoin attribute.
+func StrokeLinejoin(v string) html.Node {
+	return html.Attr("stroke-linejoin", v)
+}
+
+// StrokeMiterlimit returns a global stroke-miterlimit attribute.
+func StrokeMiterlimit(v string) html.Node {
+	return html.Attr("stroke-miterlimit", v)
+}
+
+// StrokeOpacity returns a global stroke-opacity attribute.
+func StrokeOpacity(v string) html.Node {
+	return html.Attr("stroke-opacity", v)
+}
+
+// StrokeWidth returns a global stroke-width attribute.
+func StrokeWidth(v string) html.Node {
+	return html.Attr("stroke-width", v)
+}
+
+// StyleAttr returns a global style attribute.
+func StyleAttr(v string) html.Node {
+	return html.Attr("style", v)
+}
+
+// SurfaceScale returns a surfaceScale attribute, used on feDiffuseLighting and feSpecularLighting elements.
+func SurfaceScale(v string) html.Node {
+	return html.Attr("surfaceScale", v)
+}
+
+// SystemLanguage returns a global systemLanguage attribute.
+func SystemLanguage(v string) html.Node {
+	return html.Attr("systemLanguage", v)
+}
+
+// TabIndex returns a global tabindex attribute.
+func TabIndex(v string) html.Node {
+	return html.Attr("tabindex", v)
+}
+
+// TableValues returns a tableValues attribute, used on feFuncA, feFuncB, feFuncG, and feFuncR elements.
+func TableValues(v string) html.Node {
+	return html.Attr("tableValues", v)
+}
+
+// Target returns a target attribute, used on a elements.
+func Target(v string) html.Node {
+	return html.Attr("target", v)
+}
+
+// TargetX returns a targetX attribute, used on feConvolveMatrix elements.
+func TargetX(v string) html.Node {
+	return html.Attr("targetX", v)
+}
+
+// TargetY returns a targetY attribute, used on feConvolveMatrix elements.
+func TargetY(v string) html.Node {
+	return html.Attr("targetY", v)
+}
+
+// TextAnchor returns a global text-anchor attribute.
+func TextAnchor(v string) html.Node {
+	return html.Attr("text-anchor", v)
+}
+
+// TextDecoration returns a global text-decoration attribute.
+func TextDecoration(v string) html.Node {
+	return html.Attr("text-decoration", v)
+}
+
+// TextRendering returns a global text-rendering attribute.
+func TextRendering(v string) html.Node {
+	return html.Attr("text-rendering", v)
+}
+
+// TextLength returns a textLength attribute, used on text, textPath, and tspan elements.
+func TextLength(v string) html.Node {
+	return html.Attr("textLength", v)
+}
+
+// To returns a to attribute, used on animate, animateMotion, animateTransform, and set elements.
+func To(v string) html.Node {
+	return html.Attr("to", v)
+}
+
+// Transform returns a global transform attribute.
+func Transform(v string) html.Node {
+	return html.Attr("transform", v)
+}
+
+// TransformOrigin returns a global transform-origin attribute.
+func TransformOrigin(v string) html.Node {
+	return html.Attr("transform-origin", v)
+}
+
+// Type returns a type attribute, used on a, animateTransform, feColorMatrix, feTurbulence, script, style, feFuncA, feFuncB, feFuncG, and feFuncR elements.
+func Type(v string) html.Node {
+	return html.Attr("type", v)
+}
+
+// UnicodeBidi returns a global unicode-bidi attribute.
+func UnicodeBidi(v string) html.Node {
+	return html.Attr("unicode-bidi", v)
+}
+
+// Values returns a values attribute, used on animate, animateMotion, animateTransform, and feColorMatrix elements.
+func Values(v string) html.Node {
+	return html.Attr("values", v)
+}
+
+// VectorEffect returns a global vector-effect attribute.
+func VectorEffect(v string) html.Node {
+	return html.Attr("vector-effect", v)
+}
+
+// ViewBox returns a viewBox attribute, used on marker, pattern, svg, symbol, and view elements.
+func ViewBox(v string) html.Node {
+	return html.Attr("viewBox", v)
+}
+
+// Visibility returns a global visibility attribute.
+func Visibility(v string) html.Node {
+	return html.Attr("visibility", v)
+}
+
+// Width returns a width attribute, used on feBlend, feColorMatrix, feComponentTransfer, feComposite, feConvolveMatrix, feDiffuseLighting, feDisplacementMap, feDropShadow, feFlood, feGaussianBlur, feImage, feMerge, feMorphology, feOffset, feSpecularLighting, feTile, feTurbulence, filter, foreignObject, image, mask, pattern, rect, svg, symbol, and use elements.
+func Width(v string) html.Node {
+	return html.Attr("width", v)
+}
+
+// WordSpacing returns a global word-spacing attribute.
+func WordSpacing(v string) html.Node {
+	return html.Attr("word-spacing", v)
+}
+
+// WritingMode returns a global writing-mode attribute.
+func WritingMode(v string) html.Node {
+	return html.Attr("writing-mode", v)
+}
+
+// X returns a x attribute, used on feBlend, feColorMatrix, feComponentTransfer, feComposite, feConvolveMatrix, feDiffuseLighting, feDisplacementMap, feDropShadow, feFlood, feGaussianBlur, feImage, feMerge, feMorphology, feOffset, fePointLight, feSpecularLighting, feSpotLight, feTile, feTurbulence, filter, foreignObject, image, mask, pattern, rect, svg, symbol, text, tspan, and use elements.
+func X(v string) html.Node {
+	return html.Attr("x", v)
+}
+
+// X1 returns a x1 attribute, used on line and linearGradient elements.
+func X1(v string) html.Node {
+	return html.Attr("x1", v)
+}
+
+// X2 returns a x2 attribute, used on line and linearGradient elements.
+func X2(v string) html.Node {
+	return html.Attr("x2", v)
+}
+
+// XChannelSelector returns a xChannelSelector attribute, used on feDisplacementMap elements.
+func XChannelSelector(v string) html.Node {
+	return html.Attr("xChannelSelector", v)
+}
+
+// Y returns a y attribute, used on feBlend, feColorMatrix, feComponentTransfer, feComposite, feConvolveMatrix, feDiffuseLighting, feDisplacementMap, feDropShadow, feFlood, feGaussianBlur, feImage, feMerge, feMorphology, feOffset, fePointLight, feSpecularLighting, feSpotLight, feTile, feTurbulence, filter, foreignObject, image, mask, pattern, rect, svg, symbol, text, tspan, and use elements.
+func Y(v string) html.Node {
+	return html.Attr("y", v)
+}
+
+// Y1 returns a y1 attribute, used on line and linearGradient elements.
+func Y1(v string) html.Node {
+	return html.Attr("y1", v)
+}
+
+// Y2 returns a y2 attribute, used on line and linearGradient elements.
+func Y2(v string) html.Node {
+	return html.Attr("y2", v)
+}
+
+// YChannelSelector returns a yChannelSelector attribute, used on feDisplacementMap elements.
+func YChannelSelector(v string) html.Node {
+	return html.Attr("yChannelSelector", v)
+}
+
+// Z returns a z attribute, used on fePointLight and feSpotLight elements.
+func Z(v string) html.Node {
+	return html.Attr("z", v)
+}
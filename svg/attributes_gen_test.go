@@ -0,0 +1,206 @@
+// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package svg
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/melias122/html"
+)
+
+func TestSimpleAttributes(t *testing.T) {
+	cases := map[string]func(string) html.Node{
+		"accumulate":                  Accumulate,
+		"additive":                    Additive,
+		"alignment-baseline":          AlignmentBaseline,
+		"amplitude":                   Amplitude,
+		"attributeName":               AttributeName,
+		"azimuth":                     Azimuth,
+		"baseFrequency":               BaseFrequency,
+		"baseline-shift":              BaselineShift,
+		"begin":                       Begin,
+		"bias":                        Bias,
+		"by":                          By,
+		"calcMode":                    CalcMode,
+		"class":                       Class,
+		"clip-path":                   ClipPathAttr,
+		"clip-rule":                   ClipRule,
+		"clipPathUnits":               ClipPathUnits,
+		"color":                       Color,
+		"color-interpolation":         ColorInterpolation,
+		"color-interpolation-filters": ColorInterpolationFilters,
+		"crossorigin":                 CrossOrigin,
+		"cursor":                      Cursor,
+		"cx":                          Cx,
+		"cy":                          Cy,
+		"d":                           D,
+		"diffuseConstant":             DiffuseConstant,
+		"direction":                   Direction,
+		"display":                     Display,
+		"divisor":                     Divisor,
+		"dominant-baseline":           DominantBaseline,
+		"download":                    Download,
+		"dur":                         Dur,
+		"dx":                          Dx,
+		"dy":                          Dy,
+		"edgeMode":                    EdgeMode,
+		"elevation":                   Elevation,
+		"end":                         End,
+		"exponent":                    Exponent,
+		"fill":                        Fill,
+		"fill-opacity":                FillOpacity,
+		"fill-rule":                   FillRule,
+		"filter":                      FilterAttr,
+		"filterUnits":                 FilterUnits,
+		"flood-color":                 FloodColor,
+		"flood-opacity":               FloodOpacity,
+		"font-family":                 FontFamily,
+		"font-size":                   FontSize,
+		"font-size-adjust":            FontSizeAdjust,
+		"font-stretch":                FontStretch,
+		"font-style":                  FontStyle,
+		"font-variant":                FontVariant,
+		"font-weight":                 FontWeight,
+		"fr":                          Fr,
+		"from":                        From,
+		"fx":                          Fx,
+		"fy":                          Fy,
+		"gradientTransform":           GradientTransform,
+		"gradientUnits":               GradientUnits,
+		"height":                      Height,
+		"href":                        Href,
+		"hreflang":                    HrefLang,
+		"id":                          ID,
+		"image-rendering":             ImageRendering,
+		"in":                          In,
+		"in2":                         In2,
+		"intercept":                   Intercept,
+		"k1":                          K1,
+		"k2":                          K2,
+		"k3":                          K3,
+		"k4":                          K4,
+		"kernelMatrix":                KernelMatrix,
+		"kernelUnitLength":            KernelUnitLength,
+		"keyPoints":                   KeyPoints,
+		"keySplines":                  KeySplines,
+		"keyTimes":                    KeyTimes,
+		"lang":                        Lang,
+		"lengthAdjust":                LengthAdjust,
+		"letter-spacing":              LetterSpacing,
+		"lighting-color":              LightingColor,
+		"limitingConeAngle":           LimitingConeAngle,
+		"marker-end":                  MarkerEnd,
+		"marker-mid":                  MarkerMid,
+		"marker-start":                MarkerStart,
+		"markerHeight":                MarkerHeight,
+		"markerUnits":                 MarkerUnits,
+		"markerWidth":                 MarkerWidth,
+		"mask":                        MaskAttr,
+		"mask-type":                   MaskType,
+		"maskContentUnits":            MaskContentUnits,
+		"maskUnits":                   MaskUnits,
+		"max":                         Max,
+		"media":                       Media,
+		"method":                      Method,
+		"min":                         Min,
+		"mode":                        Mode,
+		"numOctaves":                  NumOctaves,
+		"offset":                      Offset,
+		"opacity":                     Opacity,
+		"operator":                    Operator,
+		"order":                       Order,
+		"orient":                      Orient,
+		"overflow":                    Overflow,
+		"paint-order":                 PaintOrder,
+		"path":                        PathAttr,
+		"pathLength":                  PathLength,
+		"patternContentUnits":         PatternContentUnits,
+		"patternTransform":            PatternTransform,
+		"patternUnits":                PatternUnits,
+		"ping":                        Ping,
+		"pointer-events":              PointerEvents,
+		"points":                      Points,
+		"pointsAtX":                   PointsAtX,
+		"pointsAtY":                   PointsAtY,
+		"pointsAtZ":                   PointsAtZ,
+		"preserveAlpha":               PreserveAlpha,
+		"preserveAspectRatio":         PreserveAspectRatio,
+		"primitiveUnits":              PrimitiveUnits,
+		"r":                           R,
+		"radius":                      Radius,
+		"referrerpolicy":              ReferrerPolicy,
+		"refX":                        RefX,
+		"refY":                        RefY,
+		"rel":                         Rel,
+		"repeatCount":                 RepeatCount,
+		"repeatDur":                   RepeatDur,
+		"restart":                     Restart,
+		"result":                      Result,
+		"rotate":                      Rotate,
+		"rx":                          Rx,
+		"ry":                          Ry,
+		"scale":                       Scale,
+		"seed":                        Seed,
+		"shape-rendering":             ShapeRendering,
+		"side":                        Side,
+		"slope":                       Slope,
+		"spacing":                     Spacing,
+		"specularConstant":            SpecularConstant,
+		"specularExponent":            SpecularExponent,
+		"spreadMethod":                SpreadMethod,
+		"startOffset":                 StartOffset,
+		"stdDeviation":                StdDeviation,
+		"stitchTiles":                 StitchTiles,
+		"stop-color":                  StopColor,
+		"stop-opacity":                StopOpacity,
+		"stroke":                      Stroke,
+		"stroke-dasharray":            StrokeDasharray,
+		"stroke-dashoffset":           StrokeDashoffset,
+		"stroke-linecap":              StrokeLinecap,
+		"stroke-linejoin":             StrokeLinejoin,
+		"stroke-miterlimit":           StrokeMiterlimit,
+		"stroke-opacity":              StrokeOpacity,
+		"stroke-width":                StrokeWidth,
+		"style":                       StyleAttr,
+		"surfaceScale":                SurfaceScale,
+		"systemLanguage":              SystemLanguage,
+		"tabindex":                    TabIndex,
+		"tableValues":                 TableValues,
+		"target":                      Target,
+		"targetX":                     TargetX,
+		"targetY":                     TargetY,
+		"text-anchor":                 TextAnchor,
+		"text-decoration":             TextDecoration,
+		"text-rendering":              TextRendering,
+		"textLength":                  TextLength,
+		"to":                          To,
+		"transform":                   Transform,
+		"transform-origin":            TransformOrigin,
+		"type":                        Type,
+		"unicode-bidi":                UnicodeBidi,
+		"values":                      Values,
+		"vector-effect":               VectorEffect,
+		"viewBox":                     ViewBox,
+		"visibility":                  Visibility,
+		"width":                       Width,
+		"word-spacing":                WordSpacing,
+		"writing-mode":                WritingMode,
+		"x":                           X,
+		"x1":                          X1,
+		"x2":                          X2,
+		"xChannelSelector":            XChannelSelector,
+		"y":                           Y,
+		"y1":                          Y1,
+		"y2":                          Y2,
+		"yChannelSelector":            YChannelSelector,
+		"z":                           Z,
+	}
+
+	for name, fn := range cases {
+		t.Run(fmt.Sprintf(`should output %v="hat"`, name), func(t *testing.T) {
+			n := html.El("div", fn("hat"))
+			Equal(t, fmt.Sprintf(`<div %v="hat"></div>`, name), n)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package charts
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/melias122/html"
+	"github.com/melias122/html/svg"
+)
+
+// Bar chart of the series, with a group of bars for each label on the x axis.
+// Bars start at zero, so negative values point down.
+func Bar(labels []string, series []Series, opts ...Option) html.Node {
+	o := newOptions(600, 300, opts)
+	legend, legendHeight := o.legend(names(series))
+
+	min, max := extent(series)
+	p := o.plot(math.Min(min, 0), math.Max(max, 0), legendHeight)
+
+	n := points(labels, series)
+	band := p.width / math.Max(float64(n), 1)
+	x := func(i int) float64 {
+		return p.left + band*(float64(i)+0.5)
+	}
+	// The bars take up 80% of each band, leaving a gap between groups.
+	width := band * 0.8 / math.Max(float64(len(series)), 1)
+
+	var groups []html.Node
+	for i, s := range series {
+		var bars []html.Node
+		for j, v := range s.Values {
+			if !finite(v) {
+				continue
+			}
+			var label string
+			if j < len(labels) {
+				label = labels[j]
+			}
+			top := p.y(math.Min(math.Max(v, p.lo), p.hi))
+			y, height := math.Min(top, p.base()), math.Abs(top-p.base())
+			bars = append(bars, svg.Rect(
+				svg.X(num(p.left+band*(float64(j)+0.1)+width*float64(i))), svg.Y(num(y)),
+				svg.Width(num(width)), svg.Height(num(height)),
+				svg.Title(html.Text(tooltip(s.Name, label, p.format(v)))),
+			))
+		}
+		groups = append(groups, svg.G(svg.Class("series series-"+strconv.Itoa(i)), svg.Fill(o.color(i)), html.Group(bars)))
+	}
+
+	return o.svg("bar", legend, p.axes(labels, n, x), html.Group(groups))
+}
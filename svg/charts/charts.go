@@ -0,0 +1,388 @@
+// Package charts provides simple SVG charts, rendered from Go slices without JavaScript.
+//
+// Charts are svg elements with a viewBox, so they scale to the width of their container,
+// and they use currentColor for text and grid lines, so they follow the surrounding text color.
+// The output only depends on the input, so charts can be tested with snapshots.
+package charts
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/melias122/html"
+	"github.com/melias122/html/svg"
+)
+
+// Series is a named list of values, one for each label of a chart.
+// Values that are NaN or infinite are left out, which leaves a gap in line and area charts.
+type Series struct {
+	Name   string
+	Values []float64
+}
+
+// Option configures a chart.
+type Option func(*options)
+
+type options struct {
+	title, description string
+	width, height      float64
+	colors             []string
+	hideLegend         bool
+	hasYRange          bool
+	yMin, yMax         float64
+	format             func(float64) string
+}
+
+// palette is the default list of series colors.
+var palette = []string{"#4e79a7", "#f28e2b", "#e15759", "#76b7b2", "#59a14f", "#edc948", "#b07aa1", "#ff9da7", "#9c755f", "#bab0ac"}
+
+// Title of the chart, rendered as a title element, which names the chart for assistive technology.
+func Title(title string) Option {
+	return func(o *options) {
+		o.title = title
+	}
+}
+
+// Description of the chart, rendered as a desc element, which describes the chart for assistive technology.
+func Description(description string) Option {
+	return func(o *options) {
+		o.description = description
+	}
+}
+
+// Size of the chart in the viewBox. Line, area, and bar charts are 600 by 300 by default,
+// pie charts are 300 by 300, and sparklines are 100 by 20.
+func Size(width, height float64) Option {
+	return func(o *options) {
+		o.width = width
+		o.height = height
+	}
+}
+
+// Colors of the series or pie slices, which are repeated if there are more series than colors.
+func Colors(colors ...string) Option {
+	return func(o *options) {
+		if len(colors) > 0 {
+			o.colors = colors
+		}
+	}
+}
+
+// HideLegend leaves out the legend, which is shown by default if any series has a name, and for pie charts.
+func HideLegend() Option {
+	return func(o *options) {
+		o.hideLegend = true
+	}
+}
+
+// YRange sets the range of the y axis, instead of rounding the range of the values to nice tick values.
+func YRange(min, max float64) Option {
+	return func(o *options) {
+		o.hasYRange = true
+		o.yMin = min
+		o.yMax = max
+	}
+}
+
+// YFormat formats y axis tick labels, and values in bar and pie tooltips.
+func YFormat(format func(float64) string) Option {
+	return func(o *options) {
+		o.format = format
+	}
+}
+
+func newOptions(width, height float64, opts []Option) options {
+	o := options{width: width, height: height, colors: palette}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+func (o options) color(i int) string {
+	return o.colors[i%len(o.colors)]
+}
+
+// svg root element of a chart of the kind, with title and description.
+func (o options) svg(kind string, children ...html.Node) html.Node {
+	return svg.SVG(svg.ViewBox("0 0 "+num(o.width)+" "+num(o.height)), html.Role("img"), svg.Class("chart chart-"+kind),
+		html.If(o.title != "", svg.Title(html.Text(o.title))),
+		html.If(o.description != "", svg.Desc(html.Text(o.description))),
+		html.Group(children),
+	)
+}
+
+const (
+	fontSize  = 12
+	charWidth = 7
+	padding   = 10
+)
+
+// textWidth estimates the width of text in the chart font size.
+func textWidth(s string) float64 {
+	return float64(utf8.RuneCountInString(s) * charWidth)
+}
+
+// legend for the names, and its height, which is zero without a legend.
+func (o options) legend(names []string) (html.Node, float64) {
+	named := false
+	for _, name := range names {
+		named = named || name != ""
+	}
+	if o.hideLegend || !named {
+		return nil, 0
+	}
+
+	var items []html.Node
+	x := 0.0
+	for i, name := range names {
+		if name == "" {
+			continue
+		}
+		items = append(items,
+			svg.Rect(svg.X(num(x)), svg.Y("0"), svg.Width("10"), svg.Height("10"), svg.Fill(o.color(i))),
+			svg.Text(svg.X(num(x+14)), svg.Y("9"), html.Text(name)),
+		)
+		x += 14 + textWidth(name) + 16
+	}
+	return svg.G(svg.Class("legend"), svg.Transform("translate(10 10)"), svg.FontSize(strconv.Itoa(fontSize)),
+		svg.Fill("currentColor"), html.Group(items)), 24
+}
+
+// plot is the area of line, area, and bar charts inside the axes.
+type plot struct {
+	left, top, width, height float64
+	// lo and hi are the range of the y axis.
+	lo, hi float64
+	ticks  []float64
+	format func(float64) string
+}
+
+// plot with a y axis for values from min to max, below a legend of the given height.
+func (o options) plot(min, max, legendHeight float64) plot {
+	ticks, step := niceTicks(min, max, 5)
+	p := plot{lo: ticks[0], hi: ticks[len(ticks)-1], ticks: ticks, format: o.format}
+	if o.hasYRange && o.yMax > o.yMin {
+		p.lo, p.hi = o.yMin, o.yMax
+		ticks, step = niceTicks(o.yMin, o.yMax, 5)
+		p.ticks = nil
+		for _, t := range ticks {
+			if t >= p.lo && t <= p.hi {
+				p.ticks = append(p.ticks, t)
+			}
+		}
+	}
+	if p.format == nil {
+		p.format = tickFormat(step)
+	}
+
+	labelWidth := 0.0
+	for _, t := range p.ticks {
+		labelWidth = math.Max(labelWidth, textWidth(p.format(t)))
+	}
+	p.left = padding + labelWidth + 6
+	p.top = padding + legendHeight
+	// Sizes too small for the labels leave an empty plot.
+	p.width = math.Max(o.width-p.left-padding, 0)
+	p.height = math.Max(o.height-p.top-24, 0)
+	return p
+}
+
+// y coordinate of the value.
+func (p plot) y(v float64) float64 {
+	return p.top + p.height - fraction(v, p.lo, p.hi)*p.height
+}
+
+// fraction of the way from lo to hi that v is, also when the range overflows,
+// and the middle for values too large to tell apart.
+func fraction(v, lo, hi float64) float64 {
+	if lo == hi {
+		return 0.5
+	}
+	if d := hi - lo; !math.IsInf(d, 0) {
+		return (v - lo) / d
+	}
+	return (v/2 - lo/2) / (hi/2 - lo/2)
+}
+
+// base is the y coordinate of zero, or the nearest edge of the plot.
+func (p plot) base() float64 {
+	return p.y(math.Min(math.Max(0, p.lo), p.hi))
+}
+
+// axes with grid lines and labels for the y ticks, and n labels on the x axis at the x coordinates.
+// Labels are skipped evenly if they would overlap.
+func (p plot) axes(labels []string, n int, x func(i int) float64) html.Node {
+	var grid, yLabels, xLabels []html.Node
+	for _, t := range p.ticks {
+		y := num(p.y(t))
+		grid = append(grid, svg.Line(svg.X1(num(p.left)), svg.X2(num(p.left+p.width)), svg.Y1(y), svg.Y2(y)))
+		yLabels = append(yLabels, svg.Text(svg.X(num(p.left-6)), svg.Y(y), html.Text(p.format(t))))
+	}
+
+	if n > len(labels) {
+		n = len(labels)
+	}
+	maxWidth := 0.0
+	for _, label := range labels[:n] {
+		maxWidth = math.Max(maxWidth, textWidth(label))
+	}
+	skip := 1
+	if n > 1 {
+		if space := p.width / float64(n); space <= 0 {
+			skip = n
+		} else if maxWidth+8 > space {
+			skip = int(math.Min(math.Ceil((maxWidth+8)/space), float64(n)))
+		}
+	}
+	for i := 0; i < n; i += skip {
+		xLabels = append(xLabels, svg.Text(svg.X(num(x(i))), svg.Y(num(p.top+p.height+18)), html.Text(labels[i])))
+	}
+
+	return html.Group([]html.Node{
+		svg.G(svg.Class("grid"), svg.Stroke("currentColor"), svg.StrokeOpacity(".15"), html.Group(grid)),
+		svg.G(svg.Class("axis axis-y"), svg.Fill("currentColor"), svg.FontSize(strconv.Itoa(fontSize)), svg.TextAnchor("end"),
+			svg.DominantBaseline("middle"), html.Group(yLabels)),
+		svg.G(svg.Class("axis axis-x"), svg.Fill("currentColor"), svg.FontSize(strconv.Itoa(fontSize)), svg.TextAnchor("middle"),
+			html.Group(xLabels)),
+	})
+}
+
+// niceTicks returns about count ticks covering min to max, and the step between them,
+// which is 1, 2, or 5 times a power of ten, whichever is nearest to an even split.
+// If there is no such step for extreme values, the ticks are just min and max.
+func niceTicks(min, max float64, count int) ([]float64, float64) {
+	if min > max {
+		min, max = max, min
+	}
+	if min == max {
+		if min == 0 {
+			max = 1
+		} else {
+			min, max = min-math.Abs(min)/2, max+math.Abs(max)/2
+		}
+		if min == max {
+			// Too small to halve.
+			min, max = min-1, max+1
+		}
+	}
+	min, max = math.Max(min, -math.MaxFloat64), math.Min(max, math.MaxFloat64)
+
+	raw := (max - min) / float64(count)
+	if math.IsInf(raw, 0) {
+		raw = max/float64(count) - min/float64(count)
+	}
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	var step float64
+	switch f := raw / magnitude; {
+	case f < 1.5:
+		step = magnitude
+	case f < 3:
+		step = 2 * magnitude
+	case f < 7:
+		step = 5 * magnitude
+	default:
+		step = 10 * magnitude
+	}
+
+	start := math.Floor(min/step + 1e-9)
+	end := math.Ceil(max/step - 1e-9)
+	// Steps lost to rounding, and indexes too large to count up by one, make no ticks or endless ones.
+	if !(end > start) || end-start > float64(4*count) || math.Abs(start) > 1<<52 || math.Abs(end) > 1<<52 {
+		return []float64{min, max}, raw
+	}
+	var ticks []float64
+	for i := start; i <= end; i++ {
+		t := i * step
+		if t == 0 {
+			// Also for negative zero.
+			t = 0
+		}
+		ticks = append(ticks, t)
+	}
+	if !finite(ticks[0]) || !finite(ticks[len(ticks)-1]) {
+		return []float64{min, max}, raw
+	}
+	return ticks, step
+}
+
+// tickFormat formats ticks with as many decimals as the step needs,
+// or in exponent notation for very small and large steps.
+func tickFormat(step float64) func(float64) string {
+	if step < 1e-6 || step >= 1e15 {
+		return func(v float64) string {
+			return strconv.FormatFloat(v, 'g', 6, 64)
+		}
+	}
+	decimals := 0
+	if step < 1 {
+		decimals = int(math.Ceil(-math.Log10(step) - 1e-9))
+	}
+	return func(v float64) string {
+		return strconv.FormatFloat(v, 'f', decimals, 64)
+	}
+}
+
+// num formats a coordinate compactly, rounded to 2 decimals.
+func num(v float64) string {
+	v = math.Round(v*100) / 100
+	if v == 0 {
+		return "0"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func finite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// extent of the finite values in the series, which is 0 to 0 without any.
+func extent(series []Series) (float64, float64) {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, s := range series {
+		for _, v := range s.Values {
+			if finite(v) {
+				min, max = math.Min(min, v), math.Max(max, v)
+			}
+		}
+	}
+	if min > max {
+		return 0, 0
+	}
+	return min, max
+}
+
+// points is the number of points on the x axis, for the labels or the longest series.
+func points(labels []string, series []Series) int {
+	n := len(labels)
+	for _, s := range series {
+		if len(s.Values) > n {
+			n = len(s.Values)
+		}
+	}
+	return n
+}
+
+func names(series []Series) []string {
+	var names []string
+	for _, s := range series {
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+// tooltip text for a value with its label, and series name if it has one.
+func tooltip(name, label, value string) string {
+	var parts []string
+	for _, s := range []string{name, label} {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	if len(parts) == 0 {
+		return value
+	}
+	return strings.Join(parts, ", ") + ": " + value
+}
@@ -0,0 +1,160 @@
+package charts
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/melias122/html"
+	"github.com/melias122/html/htmltest"
+	"github.com/melias122/html/snapshot"
+)
+
+var (
+	months = []string{"Jan", "Feb", "Mar", "Apr", "May", "Jun"}
+	sales  = []Series{
+		{Name: "Online", Values: []float64{12, 19, 3, 5, 2, 3}},
+		{Name: "Stores", Values: []float64{7, 11, 15, 8, 13, 9}},
+	}
+)
+
+func TestLine(t *testing.T) {
+	t.Run("with legend, title, and description", func(t *testing.T) {
+		snapshot.Match(t, Line(months, sales, Title("Sales"), Description("Sales per month by channel.")))
+	})
+
+	t.Run("with gaps and a single point", func(t *testing.T) {
+		snapshot.Match(t, Line(months, []Series{{Values: []float64{1, 2, math.NaN(), 4, math.NaN(), 0.5}}},
+			Size(300, 150)))
+	})
+
+	t.Run("without values", func(t *testing.T) {
+		snapshot.Match(t, Line(nil, nil))
+	})
+}
+
+func TestArea(t *testing.T) {
+	t.Run("fills to zero, with negative values", func(t *testing.T) {
+		snapshot.Match(t, Area(months, []Series{{Name: "Profit", Values: []float64{-2, 1.5, 4, 3.25, -1, 2}}},
+			Colors("tomato"), HideLegend()))
+	})
+}
+
+func TestBar(t *testing.T) {
+	t.Run("groups bars by label", func(t *testing.T) {
+		snapshot.Match(t, Bar(months, sales, Title("Sales"), YFormat(func(v float64) string {
+			return fmt.Sprintf("$%vk", v)
+		})))
+	})
+
+	t.Run("with negative values and a y range", func(t *testing.T) {
+		snapshot.Match(t, Bar([]string{"a", "b", "c"}, []Series{{Values: []float64{-5, 10, 30}}}, YRange(-10, 20)))
+	})
+}
+
+func TestPie(t *testing.T) {
+	t.Run("has a slice for each positive value", func(t *testing.T) {
+		snapshot.Match(t, Pie([]string{"Chrome", "Firefox", "Safari", "Other"}, []float64{65, 15, 15, 0}, Title("Browsers")))
+	})
+
+	t.Run("is a circle with a single value", func(t *testing.T) {
+		snapshot.Match(t, Pie([]string{"All"}, []float64{42}, HideLegend()))
+	})
+}
+
+func TestSparkline(t *testing.T) {
+	t.Run("draws a line without axes", func(t *testing.T) {
+		htmltest.Equal(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 20" role="img" class="chart chart-sparkline">`+
+			`<path class="line" fill="none" stroke="#4e79a7" stroke-width="1.5" stroke-linecap="round" stroke-linejoin="round" d="M1.5 18.5L50 1.5 98.5 10"></path></svg>`,
+			Sparkline([]float64{1, 3, 2}))
+	})
+
+	t.Run("has title and desc first for assistive technology", func(t *testing.T) {
+		n := Sparkline([]float64{3, 1, 4}, Title("Visitors"), Description("Visitors this week."), Size(60, 10))
+		htmltest.AssertText(t, n, "svg > title:first-child", "Visitors")
+		htmltest.AssertText(t, n, "title + desc", "Visitors this week.")
+	})
+}
+
+func TestNiceTicks(t *testing.T) {
+	tests := []struct {
+		min, max float64
+		expected []float64
+	}{
+		{0, 19, []float64{0, 5, 10, 15, 20}},
+		{3, 97, []float64{0, 20, 40, 60, 80, 100}},
+		{-2, 4, []float64{-2, -1, 0, 1, 2, 3, 4}},
+		{0, 0.3, []float64{0, 0.05, 0.1, 0.15, 0.2, 0.25, 0.3}},
+		{0, 0, []float64{0, 0.2, 0.4, 0.6, 0.8, 1}},
+		{10, 10, []float64{4, 6, 8, 10, 12, 14, 16}},
+		{1200, 1, []float64{0, 200, 400, 600, 800, 1000, 1200}},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v to %v", test.min, test.max), func(t *testing.T) {
+			actual, step := niceTicks(test.min, test.max, 5)
+			format := tickFormat(step)
+			if len(actual) != len(test.expected) {
+				t.Fatalf("expected %v but got %v", test.expected, actual)
+			}
+			for i := range actual {
+				if format(actual[i]) != format(test.expected[i]) {
+					t.Fatalf("expected %v but got %v", test.expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestSizeAndValues(t *testing.T) {
+	charts := map[string]func(opts ...Option) html.Node{
+		"line": func(opts ...Option) html.Node {
+			return Line([]string{"Mon", "Tue"}, []Series{{Values: []float64{50000, 120000}}}, opts...)
+		},
+		"bar": func(opts ...Option) html.Node {
+			return Bar([]string{"Mon", "Tue"}, []Series{{Values: []float64{50000, 120000}}}, opts...)
+		},
+		"pie": func(opts ...Option) html.Node {
+			return Pie([]string{"Mon", "Tue"}, []float64{50000, 120000}, opts...)
+		},
+		"sparkline": func(opts ...Option) html.Node {
+			return Sparkline([]float64{50000, 120000}, opts...)
+		},
+	}
+
+	for name, chart := range charts {
+		t.Run(name+" fits labels into a small size", func(t *testing.T) {
+			assertValid(t, chart(Size(60, 40)))
+		})
+	}
+
+	extremes := [][]float64{
+		{1e-320, 2e-320},
+		{5e-324, 5e-324},
+		{-math.MaxFloat64, math.MaxFloat64},
+		{math.MaxFloat64, math.MaxFloat64},
+		{1e300, 1e300 * (1 + 1e-15)},
+	}
+	for _, values := range extremes {
+		t.Run(fmt.Sprintf("with values %v", values), func(t *testing.T) {
+			labels := []string{"a", "b"}
+			assertValid(t, Line(labels, []Series{{Values: values}}))
+			assertValid(t, Area(labels, []Series{{Values: values}}))
+			assertValid(t, Bar(labels, []Series{{Values: values}}))
+			assertValid(t, Sparkline(values))
+		})
+	}
+}
+
+// assertValid fails if the chart has coordinates that are not numbers, or negative sizes.
+func assertValid(t *testing.T, n html.Node) {
+	t.Helper()
+
+	s := fmt.Sprint(n)
+	for _, invalid := range []string{"NaN", "Inf", `width="-`, `height="-`} {
+		if strings.Contains(s, invalid) {
+			t.Fatalf("%v in %v", invalid, s)
+		}
+	}
+}
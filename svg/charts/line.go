@@ -0,0 +1,134 @@
+package charts
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/melias122/html"
+	"github.com/melias122/html/svg"
+)
+
+// Line chart of the series, with the labels on the x axis.
+func Line(labels []string, series []Series, opts ...Option) html.Node {
+	return lineChart("line", labels, series, false, opts)
+}
+
+// Area chart of the series, with the labels on the x axis.
+// It's a line chart with the area between each line and zero filled.
+func Area(labels []string, series []Series, opts ...Option) html.Node {
+	return lineChart("area", labels, series, true, opts)
+}
+
+func lineChart(kind string, labels []string, series []Series, area bool, opts []Option) html.Node {
+	o := newOptions(600, 300, opts)
+	legend, legendHeight := o.legend(names(series))
+
+	min, max := extent(series)
+	if area {
+		min, max = math.Min(min, 0), math.Max(max, 0)
+	}
+	p := o.plot(min, max, legendHeight)
+
+	n := points(labels, series)
+	x := func(i int) float64 {
+		if n < 2 {
+			return p.left + p.width/2
+		}
+		return p.left + p.width*float64(i)/float64(n-1)
+	}
+
+	var lines []html.Node
+	for i, s := range series {
+		segments := segments(s.Values)
+		if len(segments) == 0 {
+			continue
+		}
+
+		line := svg.PathData().Precision(2)
+		fill := svg.PathData().Precision(2)
+		for _, seg := range segments {
+			line.MoveTo(x(seg[0]), p.y(s.Values[seg[0]]))
+			fill.MoveTo(x(seg[0]), p.base())
+			for j := seg[0]; j < seg[1]; j++ {
+				if j > seg[0] {
+					line.LineTo(x(j), p.y(s.Values[j]))
+				}
+				fill.LineTo(x(j), p.y(s.Values[j]))
+			}
+			if seg[1]-seg[0] == 1 {
+				// A single point is drawn as a dot by the round line cap.
+				line.HorizontalBy(0)
+			}
+			fill.LineTo(x(seg[1]-1), p.base()).Close()
+		}
+
+		lines = append(lines, svg.G(svg.Class("series series-"+strconv.Itoa(i)),
+			html.If(s.Name != "", svg.Title(html.Text(s.Name))),
+			html.If(area, svg.Path(svg.Class("area"), svg.Fill(o.color(i)), svg.FillOpacity(".2"), fill)),
+			svg.Path(svg.Class("line"), svg.Fill("none"), svg.Stroke(o.color(i)), svg.StrokeWidth("2"),
+				svg.StrokeLinecap("round"), svg.StrokeLinejoin("round"), line),
+		))
+	}
+
+	return o.svg(kind, legend, p.axes(labels, n, x), html.Group(lines))
+}
+
+// segments are the start and end index of each run of finite values.
+func segments(values []float64) [][2]int {
+	var segments [][2]int
+	start := -1
+	for i, v := range values {
+		switch {
+		case finite(v) && start < 0:
+			start = i
+		case !finite(v) && start >= 0:
+			segments = append(segments, [2]int{start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		segments = append(segments, [2]int{start, len(values)})
+	}
+	return segments
+}
+
+// Sparkline is a small line chart of the values without axes, to be shown in text or tables.
+func Sparkline(values []float64, opts ...Option) html.Node {
+	o := newOptions(100, 20, opts)
+
+	lo, hi := extent([]Series{{Values: values}})
+	if o.hasYRange && o.yMax > o.yMin {
+		lo, hi = o.yMin, o.yMax
+	}
+	if lo == hi {
+		lo, hi = lo-1, hi+1
+	}
+
+	// The line is inset by its width, so it's not cut off at the edges.
+	const inset = 1.5
+	x := func(i int) float64 {
+		if len(values) < 2 {
+			return o.width / 2
+		}
+		return inset + (o.width-2*inset)*float64(i)/float64(len(values)-1)
+	}
+	y := func(v float64) float64 {
+		return o.height - inset - fraction(v, lo, hi)*(o.height-2*inset)
+	}
+
+	line := svg.PathData().Precision(2)
+	for _, seg := range segments(values) {
+		line.MoveTo(x(seg[0]), y(values[seg[0]]))
+		for j := seg[0] + 1; j < seg[1]; j++ {
+			line.LineTo(x(j), y(values[j]))
+		}
+		if seg[1]-seg[0] == 1 {
+			line.HorizontalBy(0)
+		}
+	}
+
+	return o.svg("sparkline",
+		svg.Path(svg.Class("line"), svg.Fill("none"), svg.Stroke(o.color(0)), svg.StrokeWidth("1.5"),
+			svg.StrokeLinecap("round"), svg.StrokeLinejoin("round"), line),
+	)
+}
@@ -0,0 +1,67 @@
+package charts
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/melias122/html"
+	"github.com/melias122/html/svg"
+)
+
+// Pie chart of the values, with a slice for each label, starting at the top and going clockwise.
+// Values that aren't positive are left out.
+func Pie(labels []string, values []float64, opts ...Option) html.Node {
+	o := newOptions(300, 300, opts)
+	legend, legendHeight := o.legend(labels)
+
+	format := o.format
+	if format == nil {
+		format = num
+	}
+
+	total := 0.0
+	for _, v := range values {
+		if finite(v) && v > 0 {
+			total += v
+		}
+	}
+
+	cx := o.width / 2
+	cy := legendHeight + (o.height-legendHeight)/2
+	r := math.Max(math.Min(o.width, o.height-legendHeight)/2-padding, 0)
+
+	var slices []html.Node
+	angle := -math.Pi / 2
+	for i, v := range values {
+		if !finite(v) || v <= 0 {
+			continue
+		}
+		var label string
+		if i < len(labels) {
+			label = labels[i]
+		}
+		title := svg.Title(html.Text(tooltip("", label, format(v)+" ("+percent(v/total)+")")))
+
+		sweep := v / total * 2 * math.Pi
+		if v == total {
+			slices = append(slices, svg.Circle(svg.Class("slice"), svg.Cx(num(cx)), svg.Cy(num(cy)), svg.R(num(r)),
+				svg.Fill(o.color(i)), title))
+			continue
+		}
+
+		d := svg.PathData().Precision(2).
+			MoveTo(cx, cy).
+			LineTo(cx+r*math.Cos(angle), cy+r*math.Sin(angle)).
+			Arc(r, r, 0, sweep > math.Pi, true, cx+r*math.Cos(angle+sweep), cy+r*math.Sin(angle+sweep)).
+			Close()
+		slices = append(slices, svg.Path(svg.Class("slice"), svg.Fill(o.color(i)), d, title))
+		angle += sweep
+	}
+
+	return o.svg("pie", legend, svg.G(svg.Class("slices"), svg.Stroke("#fff"), html.Group(slices)))
+}
+
+// percent formats a fraction as a percentage with at most one decimal.
+func percent(f float64) string {
+	return strconv.FormatFloat(math.Round(f*1000)/10, 'f', -1, 64) + "%"
+}
@@ -1,15 +1,16 @@
-// Package svg provides common SVG elements and attributes.
+// Package svg provides SVG elements and attributes.
 // See https://developer.mozilla.org/en-US/docs/Web/SVG/Element for an overview.
+//
+// Elements and attributes with the same name have an El or Attr suffix, like ClipPathEl and ClipPathAttr.
 package svg
 
 import (
 	"github.com/melias122/html"
 )
 
-func Path(children ...html.Node) html.Node {
-	return html.El("path", children...)
-}
+//go:generate go run ../internal/cmd/generate -spec ../internal/spec/svg.json -names ../svgnames_gen.go
 
+// SVG returns an svg element with the SVG namespace and the given children.
 func SVG(children ...html.Node) html.Node {
 	return html.El("svg", html.Attr("xmlns", "http://www.w3.org/2000/svg"), html.Group(children))
 }
@@ -0,0 +1,315 @@
+// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package svg
+
+import "github.com/melias122/html"
+
+// A returns an a element with the given children.
+func A(children ...html.Node) html.Node {
+	return html.El("a", children...)
+}
+
+// Animate returns an animate element with the given children.
+func Animate(children ...html.Node) html.Node {
+	return html.El("animate", children...)
+}
+
+// AnimateMotion returns an animateMotion element with the given children.
+func AnimateMotion(children ...html.Node) html.Node {
+	return html.El("animateMotion", children...)
+}
+
+// AnimateTransform returns an animateTransform element with the given children.
+func AnimateTransform(children ...html.Node) html.Node {
+	return html.El("animateTransform", children...)
+}
+
+// Circle returns a circle element with the given children.
+func Circle(children ...html.Node) html.Node {
+	return html.El("circle", children...)
+}
+
+// ClipPathEl returns a clipPath element with the given children.
+func ClipPathEl(children ...html.Node) html.Node {
+	return html.El("clipPath", children...)
+}
+
+// Defs returns a defs element with the given children.
+func Defs(children ...html.Node) html.Node {
+	return html.El("defs", children...)
+}
+
+// Desc returns a desc element with the given children.
+func Desc(children ...html.Node) html.Node {
+	return html.El("desc", children...)
+}
+
+// Ellipse returns an ellipse element with the given children.
+func Ellipse(children ...html.Node) html.Node {
+	return html.El("ellipse", children...)
+}
+
+// FeBlend returns a feBlend element with the given children.
+func FeBlend(children ...html.Node) html.Node {
+	return html.El("feBlend", children...)
+}
+
+// FeColorMatrix returns a feColorMatrix element with the given children.
+func FeColorMatrix(children ...html.Node) html.Node {
+	return html.El("feColorMatrix", children...)
+}
+
+// FeComponentTransfer returns a feComponentTransfer element with the given children.
+func FeComponentTransfer(children ...html.Node) html.Node {
+	return html.El("feComponentTransfer", children...)
+}
+
+// FeComposite returns a feComposite element with the given children.
+func FeComposite(children ...html.Node) html.Node {
+	return html.El("feComposite", children...)
+}
+
+// FeConvolveMatrix returns a feConvolveMatrix element with the given children.
+func FeConvolveMatrix(children ...html.Node) html.Node {
+	return html.El("feConvolveMatrix", children...)
+}
+
+// FeDiffuseLighting returns a feDiffuseLighting element with the given children.
+func FeDiffuseLighting(children ...html.Node) html.Node {
+	return html.El("feDiffuseLighting", children...)
+}
+
+// FeDisplacementMap returns a feDisplacementMap element with the given children.
+func FeDisplacementMap(children ...html.Node) html.Node {
+	return html.El("feDisplacementMap", children...)
+}
+
+// FeDistantLight returns a feDistantLight element with the given children.
+func FeDistantLight(children ...html.Node) html.Node {
+	return html.El("feDistantLight", children...)
+}
+
+// FeDropShadow returns a feDropShadow element with the given children.
+func FeDropShadow(children ...html.Node) html.Node {
+	return html.El("feDropShadow", children...)
+}
+
+// FeFlood returns a feFlood element with the given children.
+func FeFlood(children ...html.Node) html.Node {
+	return html.El("feFlood", children...)
+}
+
+// FeFuncA returns a feFuncA element with the given children.
+func FeFuncA(children ...html.Node) html.Node {
+	return html.El("feFuncA", children...)
+}
+
+// FeFuncB returns a feFuncB element with the given children.
+func FeFuncB(children ...html.Node) html.Node {
+	return html.El("feFuncB", children...)
+}
+
+// FeFuncG returns a feFuncG element with the given children.
+func FeFuncG(children ...html.Node) html.Node {
+	return html.El("feFuncG", children...)
+}
+
+// FeFuncR returns a feFuncR element with the given children.
+func FeFuncR(children ...html.Node) html.Node {
+	return html.El("feFuncR", children...)
+}
+
+// FeGaussianBlur returns a feGaussianBlur element with the given children.
+func FeGaussianBlur(children ...html.Node) html.Node {
+	return html.El("feGaussianBlur", children...)
+}
+
+// FeImage returns a feImage element with the given children.
+func FeImage(children ...html.Node) html.Node {
+	return html.El("feImage", children...)
+}
+
+// FeMerge returns a feMerge element with the given children.
+func FeMerge(children ...html.Node) html.Node {
+	return html.El("feMerge", children...)
+}
+
+// FeMergeNode returns a feMergeNode element with the given children.
+func FeMergeNode(children ...html.Node) html.Node {
+	return html.El("feMergeNode", children...)
+}
+
+// FeMorphology returns a feMorphology element with the given children.
+func FeMorphology(children ...html.Node) html.Node {
+	return html.El("feMorphology", children...)
+}
+
+// FeOffset returns a feOffset element with the given children.
+func FeOffset(children ...html.Node) html.Node {
+	return html.El("feOffset", children...)
+}
+
+// FePointLight returns a fePointLight element with the given children.
+func FePointLight(children ...html.Node) html.Node {
+	return html.El("fePointLight", children...)
+}
+
+// FeSpecularLighting returns a feSpecularLighting element with the given children.
+func FeSpecularLighting(children ...html.Node) html.Node {
+	return html.El("feSpecularLighting", children...)
+}
+
+// FeSpotLight returns a feSpotLight element with the given children.
+func FeSpotLight(children ...html.Node) html.Node {
+	return html.El("feSpotLight", children...)
+}
+
+// FeTile returns a feTile element with the given children.
+func FeTile(children ...html.Node) html.Node {
+	return html.El("feTile", children...)
+}
+
+// FeTurbulence returns a feTurbulence element with the given children.
+func FeTurbulence(children ...html.Node) html.Node {
+	return html.El("feTurbulence", children...)
+}
+
+// FilterEl returns a filter element with the given children.
+func FilterEl(children ...html.Node) html.Node {
+	return html.El("filter", children...)
+}
+
+// ForeignObject returns a foreignObject element with the given children.
+func ForeignObject(children ...html.Node) html.Node {
+	return html.El("foreignObject", children...)
+}
+
+// G returns a g element with the given children.
+func G(children ...html.Node) html.Node {
+	return html.El("g", children...)
+}
+
+// Image returns an image element with the given children.
+func Image(children ...html.Node) html.Node {
+	return html.El("image", children...)
+}
+
+// Line returns a line element with the given children.
+func Line(children ...html.Node) html.Node {
+	return html.El("line", children...)
+}
+
+// LinearGradient returns a linearGradient element with the given children.
+func LinearGradient(children ...html.Node) html.Node {
+	return html.El("linearGradient", children...)
+}
+
+// Marker returns a marker element with the given children.
+func Marker(children ...html.Node) html.Node {
+	return html.El("marker", children...)
+}
+
+// MaskEl returns a mask element with the given children.
+func MaskEl(children ...html.Node) html.Node {
+	return html.El("mask", children...)
+}
+
+// Metadata returns a metadata element with the given children.
+func Metadata(children ...html.Node) html.Node {
+	return html.El("metadata", children...)
+}
+
+// MPath returns a mpath element with the given children.
+func MPath(children ...html.Node) html.Node {
+	return html.El("mpath", children...)
+}
+
+// Path returns a path element with the given children.
+func Path(children ...html.Node) html.Node {
+	return html.El("path", children...)
+}
+
+// Pattern returns a pattern element with the given children.
+func Pattern(children ...html.Node) html.Node {
+	return html.El("pattern", children...)
+}
+
+// Polygon returns a polygon element with the given children.
+func Polygon(children ...html.Node) html.Node {
+	return html.El("polygon", children...)
+}
+
+// Polyline returns a polyline element with the given children.
+func Polyline(children ...html.Node) html.Node {
+	return html.El("polyline", children...)
+}
+
+// RadialGradient returns a radialGradient element with the given children.
+func RadialGradient(children ...html.Node) html.Node {
+	return html.El("radialGradient", children...)
+}
+
+// Rect returns a rect element with the given children.
+func Rect(children ...html.Node) html.Node {
+	return html.El("rect", children...)
+}
+
+// Script returns a script element with the given children.
+func Script(children ...html.Node) html.Node {
+	return html.El("script", children...)
+}
+
+// Set returns a set element with the given children.
+func Set(children ...html.Node) html.Node {
+	return html.El("set", children...)
+}
+
+// Stop returns a stop element with the given children.
+func Stop(children ...html.Node) html.Node {
+	return html.El("stop", children...)
+}
+
+// StyleEl returns a style element with the given children.
+func StyleEl(children ...html.Node) html.Node {
+	return html.El("style", children...)
+}
+
+// Switch returns a switch element with the given children.
+func Switch(children ...html.Node) html.Node {
+	return html.El("switch", children...)
+}
+
+// Symbol returns a symbol element with the given children.
+func Symbol(children ...html.Node) html.Node {
+	return html.El("symbol", children...)
+}
+
+// Text returns a text element with the given children.
+func Text(children ...html.Node) html.Node {
+	return html.El("text", children...)
+}
+
+// TextPath returns a textPath element with the given children.
+func TextPath(children ...html.Node) html.Node {
+	return html.El("textPath", children...)
+}
+
+// Title returns a title element with the given children.
+func Title(children ...html.Node) html.Node {
+	return html.El("title", children...)
+}
+
+// TSpan returns a tspan element with the given children.
+func TSpan(children ...html.Node) html.Node {
+	return html.El("tspan", children...)
+}
+
+// Use returns a use element with the given children.
+func Use(children ...html.Node) html.Node {
+	return html.El("use", children...)
+}
+
+// View returns a view element with the given children.
+func View(children ...html.Node) html.Node {
+	return html.El("view", children...)
+}
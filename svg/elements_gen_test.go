@@ -0,0 +1,84 @@
+// Code generated by internal/cmd/generate from the spec in internal/spec. DO NOT EDIT.
+
+package svg
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/melias122/html"
+)
+
+func TestSimpleElements(t *testing.T) {
+	cases := map[string]func(...html.Node) html.Node{
+		"a":                   A,
+		"animate":             Animate,
+		"animateMotion":       AnimateMotion,
+		"animateTransform":    AnimateTransform,
+		"circle":              Circle,
+		"clipPath":            ClipPathEl,
+		"defs":                Defs,
+		"desc":                Desc,
+		"ellipse":             Ellipse,
+		"feBlend":             FeBlend,
+		"feColorMatrix":       FeColorMatrix,
+		"feComponentTransfer": FeComponentTransfer,
+		"feComposite":         FeComposite,
+		"feConvolveMatrix":    FeConvolveMatrix,
+		"feDiffuseLighting":   FeDiffuseLighting,
+		"feDisplacementMap":   FeDisplacementMap,
+		"feDistantLight":      FeDistantLight,
+		"feDropShadow":        FeDropShadow,
+		"feFlood":             FeFlood,
+		"feFuncA":             FeFuncA,
+		"feFuncB":             FeFuncB,
+		"feFuncG":             FeFuncG,
+		"feFuncR":             FeFuncR,
+		"feGaussianBlur":      FeGaussianBlur,
+		"feImage":             FeImage,
+		"feMerge":             FeMerge,
+		"feMergeNode":         FeMergeNode,
+		"feMorphology":        FeMorphology,
+		"feOffset":            FeOffset,
+		"fePointLight":        FePointLight,
+		"feSpecularLighting":  FeSpecularLighting,
+		"feSpotLight":         FeSpotLight,
+		"feTile":              FeTile,
+		"feTurbulence":        FeTurbulence,
+		"filter":              FilterEl,
+		"foreignObject":       ForeignObject,
+		"g":                   G,
+		"image":               Image,
+		"line":                Line,
+		"linearGradient":      LinearGradient,
+		"marker":              Marker,
+		"mask":                MaskEl,
+		"metadata":            Metadata,
+		"mpath":               MPath,
+		"path":                Path,
+		"pattern":             Pattern,
+		"polygon":             Polygon,
+		"polyline":            Polyline,
+		"radialGradient":      RadialGradient,
+		"rect":                Rect,
+		"script":              Script,
+		"set":                 Set,
+		"stop":                Stop,
+		"style":               StyleEl,
+		"switch":              Switch,
+		"symbol":              Symbol,
+		"text":                Text,
+		"textPath":            TextPath,
+		"title":               Title,
+		"tspan":               TSpan,
+		"use":                 Use,
+		"view":                View,
+	}
+
+	for name, fn := range cases {
+		t.Run(fmt.Sprintf("should output %v", name), func(t *testing.T) {
+			n := fn(html.Attr("id", "hat"))
+			Equal(t, fmt.Sprintf(`<%v id="hat"></%v>`, name, name), n)
+		})
+	}
+}
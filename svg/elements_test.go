@@ -1,7 +1,6 @@
 package svg
 
 import (
-	"fmt"
 	"strings"
 	"testing"
 
@@ -28,19 +27,6 @@ func Error(t *testing.T, err error) {
 	}
 }
 
-func TestSimpleElements(t *testing.T) {
-	cases := map[string]func(...html.Node) html.Node{
-		"path": Path,
-	}
-
-	for name, fn := range cases {
-		t.Run(fmt.Sprintf("should output %v", name), func(t *testing.T) {
-			n := fn(html.Attr("id", "hat"))
-			Equal(t, fmt.Sprintf(`<%v id="hat"></%v>`, name, name), n)
-		})
-	}
-}
-
 func TestSVG(t *testing.T) {
 	t.Run("outputs svg element with xml namespace attribute", func(t *testing.T) {
 		Equal(t, `<svg xmlns="http://www.w3.org/2000/svg"><path></path></svg>`, SVG(html.El("path")))
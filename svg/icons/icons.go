@@ -0,0 +1,326 @@
+// Package icons renders icon sets from SVG files, such as ones embedded with embed.FS, as inline SVG.
+//
+// Each file is parsed once when it's loaded, and icons are rendered from the parsed Nodes,
+// with attributes like Class, Width, and Fill given by the caller.
+package icons
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/melias122/html"
+	"github.com/melias122/html/svg"
+)
+
+// Set of icons, by the names of their files without the extension.
+type Set struct {
+	icons map[string]*icon
+	// ids counts the renders of icons with IDs without a context from WithIDs, see Set.Icon.
+	ids ids
+}
+
+type icon struct {
+	svg *html.Element
+	// ids of elements in the icon, which are prefixed when it's rendered.
+	ids    map[string]struct{}
+	prefix string
+	// symbol is the content of the symbol element for Set.Sprite.
+	symbol html.Node
+}
+
+// Load the SVG files matching the patterns from fsys into a Set. The patterns are like in fs.Glob,
+// and each pattern must match at least one file.
+// Icons are named after the files without the directory and extension, so "icons/arrow-left.svg" is "arrow-left",
+// and the names must be unique.
+//
+// Each file must have an svg element. Anything outside of it, such as an XML declaration, is left out,
+// as are comments and whitespace between elements.
+func Load(fsys fs.FS, patterns ...string) (*Set, error) {
+	s := &Set{icons: map[string]*icon{}, ids: ids{next: map[string]int{}}}
+	for _, pattern := range patterns {
+		paths, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			return nil, err
+		}
+		if len(paths) == 0 {
+			return nil, fmt.Errorf("icons: pattern matches no files: %#q", pattern)
+		}
+
+		for _, p := range paths {
+			name := strings.TrimSuffix(path.Base(p), path.Ext(p))
+			if _, ok := s.icons[name]; ok {
+				return nil, fmt.Errorf("icons: more than one icon named %q", name)
+			}
+
+			b, err := fs.ReadFile(fsys, p)
+			if err != nil {
+				return nil, err
+			}
+			ic, err := parseIcon(name, string(b))
+			if err != nil {
+				return nil, fmt.Errorf("icons: %v: %w", p, err)
+			}
+			s.icons[name] = ic
+		}
+	}
+	return s, nil
+}
+
+// Must panics if err is not nil, and returns s otherwise. Use it to load a Set in a package variable:
+//
+//	//go:embed icons/*.svg
+//	var files embed.FS
+//
+//	var set = icons.Must(icons.Load(files, "icons/*.svg"))
+func Must(s *Set, err error) *Set {
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// parseIcon parses the svg element in the source, and finds its IDs.
+func parseIcon(name, source string) (*icon, error) {
+	nodes, err := html.ParseFragment(strings.NewReader(source))
+	if err != nil {
+		return nil, err
+	}
+
+	var root *html.Element
+	for _, n := range nodes {
+		if e, ok := n.(*html.Element); ok && e.Name == "svg" {
+			root = e
+			break
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("no svg element")
+	}
+
+	root = html.Transform(root, func(n html.Node) html.Node {
+		switch n := n.(type) {
+		case html.TextNode:
+			if strings.TrimSpace(string(n)) == "" {
+				return nil
+			}
+		case html.RawNode:
+			if strings.HasPrefix(string(n), "<!--") {
+				return nil
+			}
+		}
+		return n
+	}).(*html.Element)
+
+	ic := &icon{svg: root, ids: map[string]struct{}{}, prefix: idPrefix(name)}
+	html.Walk(root, func(n html.Node) bool {
+		if a, ok := n.(*html.Attribute); ok && strings.EqualFold(a.Name, "id") && a.Value != "" {
+			ic.ids[a.Value] = struct{}{}
+		}
+		return true
+	})
+
+	symbolRoot := root
+	if len(ic.ids) > 0 {
+		symbolRoot = prefixIDs(root, ic.ids, spriteID(name)+"-")
+	}
+	var symbol []html.Node
+	if viewBox, ok := root.GetAttribute("viewBox"); ok {
+		symbol = append(symbol, svg.ViewBox(viewBox))
+	}
+	ic.symbol = html.Group(append(symbol, symbolRoot.ChildNodes()...))
+
+	return ic, nil
+}
+
+// idPrefix for the IDs of the named icon, with the characters that are not letters, digits,
+// or '-' and '_' replaced by '-'.
+func idPrefix(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			return r
+		}
+		return '-'
+	}, name) + "-"
+}
+
+// spriteID is the id of the symbol of the named icon.
+func spriteID(name string) string {
+	return "icon-" + strings.TrimSuffix(idPrefix(name), "-")
+}
+
+// Names of the icons in the Set, sorted.
+func (s *Set) Names() []string {
+	names := make([]string, 0, len(s.icons))
+	for name := range s.icons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Icon returns the svg element of the named icon.
+//
+// Attribute children replace the attributes with the same names on the svg element, so for example Class
+// replaces the class of the icon, and Fill its fill color. Other children are added after the content of the icon,
+// such as a Title for accessibility. Rendering the Node fails if there is no icon with the name.
+//
+// If the icon has elements with IDs, such as gradients or clip paths, the IDs and the references to them
+// in href and url(#id) attribute values are prefixed with the icon name and a number that is different
+// each time it's rendered, like "logo-3-gradient", so they don't collide when an icon is shown more than once.
+// The numbers count up for as long as the Set is used. Render with a context from WithIDs or inside IDs
+// to count from 1 for each render of the page instead, so the same page renders the same.
+// References in style elements are not changed.
+func (s *Set) Icon(name string, children ...html.Node) html.Node {
+	ic, ok := s.icons[name]
+	if !ok {
+		return html.NodeFunc(func(io.Writer) error {
+			return fmt.Errorf("icons: no icon named %q", name)
+		})
+	}
+
+	if len(ic.ids) == 0 {
+		return withChildren(ic.svg, children)
+	}
+	return html.ContextNodeFunc(func(ctx context.Context, w io.Writer) error {
+		counter, ok := ctx.Value(idsContextKey{}).(*ids)
+		if !ok {
+			counter = &s.ids
+		}
+		return html.RenderCtx(ctx, withChildren(prefixIDs(ic.svg, ic.ids, counter.prefix(ic.prefix)), children), w)
+	})
+}
+
+type idsContextKey struct{}
+
+// ids counts the renders of icons with IDs by their prefix, for a Set or a render, see Set.Icon.
+type ids struct {
+	mu   sync.Mutex
+	next map[string]int
+}
+
+// WithIDs returns a copy of ctx in which the numbers in the IDs of icons count from 1 for each icon,
+// instead of for as long as the Set is used, so the same page renders the same. See Set.Icon.
+func WithIDs(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idsContextKey{}, &ids{next: map[string]int{}})
+}
+
+// IDs renders the Node n with a render context from WithIDs, such as a page or a body element,
+// so the IDs of the icons in it are numbered the same for each render without middleware. Like in html.WithValue,
+// n may be nil or a Group, and Nodes of AttributeType are not rendered.
+func IDs(n html.Node) html.Node {
+	return html.ContextNodeFunc(func(ctx context.Context, w io.Writer) error {
+		return html.RenderCtx(ctx, html.WithValue(idsContextKey{}, &ids{next: map[string]int{}}, n), w)
+	})
+}
+
+// prefix for the IDs of the next render of the icon with the icon prefix.
+func (s *ids) prefix(prefix string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.next[prefix]++
+	return prefix + strconv.Itoa(s.next[prefix]) + "-"
+}
+
+// Sprite returns the named icon as an svg.Sprite, which shows a symbol with the content of the icon
+// through a use element. Render it with a context from svg.WithSprites or inside svg.Sprites, and place
+// an svg.SpriteSheet after it, so the content is rendered only once, however many times the icon is shown.
+//
+// The symbol id is "icon-" followed by the name, like "icon-arrow-left". The attributes of the icon are on the svg
+// element, and attribute children replace them like in Set.Icon. IDs in the icon are prefixed with the symbol id,
+// since the symbol is rendered once.
+func (s *Set) Sprite(name string, children ...html.Node) html.Node {
+	ic, ok := s.icons[name]
+	if !ok {
+		return html.NodeFunc(func(io.Writer) error {
+			return fmt.Errorf("icons: no icon named %q", name)
+		})
+	}
+
+	attributes := &html.Element{Name: "svg", Children: ic.svg.Attributes()}
+	attributes.RemoveAttribute("xmlns")
+	return svg.Sprite(spriteID(name), ic.symbol, withChildren(attributes, children).Children...)
+}
+
+// withChildren returns a copy of e with the children added, where attribute children replace
+// the attributes with the same names.
+func withChildren(e *html.Element, children []html.Node) *html.Element {
+	c := *e
+	for _, n := range children {
+		if n == nil {
+			continue
+		}
+		if t, ok := n.(interface{ Type() html.NodeType }); ok && t.Type() == html.AttributeType {
+			for _, name := range attributeNames(n) {
+				c.RemoveAttribute(name)
+			}
+		}
+		c.Children = append(c.Children[:len(c.Children):len(c.Children)], n)
+	}
+	return &c
+}
+
+// attributeNames of the attribute Node n. Nodes that are not an *html.Attribute, such as html.Classes,
+// are rendered on an element to find them.
+func attributeNames(n html.Node) []string {
+	if a, ok := n.(*html.Attribute); ok {
+		return []string{a.Name}
+	}
+
+	nodes, _ := html.ParseFragment(strings.NewReader(html.El("x", n).(*html.Element).String()))
+	var names []string
+	for _, c := range nodes {
+		if e, ok := c.(*html.Element); ok {
+			for _, a := range e.Attributes() {
+				if a, ok := a.(*html.Attribute); ok {
+					names = append(names, a.Name)
+				}
+			}
+		}
+	}
+	return names
+}
+
+// urlReference is a reference to an element in an attribute value like fill="url(#gradient)".
+var urlReference = regexp.MustCompile(`url\(\s*(['"]?)#([^'")\s]+)`)
+
+// prefixIDs returns a copy of e, where the ids and references to them are prefixed.
+func prefixIDs(e *html.Element, ids map[string]struct{}, prefix string) *html.Element {
+	return html.Transform(e, func(n html.Node) html.Node {
+		a, ok := n.(*html.Attribute)
+		if !ok || a.Boolean {
+			return n
+		}
+
+		value := a.Value
+		switch strings.ToLower(a.Name) {
+		case "id":
+			if _, ok := ids[value]; ok {
+				value = prefix + value
+			}
+		case "href", "xlink:href":
+			if _, ok := ids[strings.TrimPrefix(value, "#")]; ok && strings.HasPrefix(value, "#") {
+				value = "#" + prefix + value[1:]
+			}
+		default:
+			value = urlReference.ReplaceAllStringFunc(value, func(ref string) string {
+				m := urlReference.FindStringSubmatch(ref)
+				if _, ok := ids[m[2]]; !ok {
+					return ref
+				}
+				return "url(" + m[1] + "#" + prefix + m[2]
+			})
+		}
+		if value == a.Value {
+			return n
+		}
+		return &html.Attribute{Name: a.Name, Value: value}
+	}).(*html.Element)
+}
@@ -0,0 +1,189 @@
+package icons_test
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/melias122/html"
+	"github.com/melias122/html/htmltest"
+	"github.com/melias122/html/svg"
+	"github.com/melias122/html/svg/icons"
+)
+
+//go:embed testdata/*.svg
+var files embed.FS
+
+var set = icons.Must(icons.Load(files, "testdata/*.svg"))
+
+func TestLoad(t *testing.T) {
+	t.Run("names icons after their files", func(t *testing.T) {
+		if names := fmt.Sprint(set.Names()); names != "[check logo]" {
+			t.Fatal("names are", names)
+		}
+	})
+
+	t.Run("returns an error if a pattern matches no files", func(t *testing.T) {
+		if _, err := icons.Load(files, "testdata/*.png"); err == nil {
+			t.Fatal("no error")
+		}
+	})
+
+	t.Run("returns an error for files without an svg element", func(t *testing.T) {
+		fsys := fstest.MapFS{"broken.svg": {Data: []byte("<p>Not an icon</p>")}}
+		if _, err := icons.Load(fsys, "*.svg"); err == nil || !strings.Contains(err.Error(), "broken.svg") {
+			t.Fatal("error is", err)
+		}
+	})
+
+	t.Run("returns an error for icons with the same name", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"outline/home.svg": {Data: []byte("<svg></svg>")},
+			"solid/home.svg":   {Data: []byte("<svg></svg>")},
+		}
+		if _, err := icons.Load(fsys, "outline/*.svg", "solid/*.svg"); err == nil {
+			t.Fatal("no error")
+		}
+	})
+}
+
+func TestSet_Icon(t *testing.T) {
+	t.Run("renders the svg element of the icon without comments and whitespace", func(t *testing.T) {
+		n := set.Icon("check")
+		expected := `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" ` +
+			`stroke="currentColor" stroke-width="2"><path d="M4 12l5 5L20 6"></path></svg>`
+		if actual := fmt.Sprint(n); actual != expected {
+			t.Fatalf("expected %v but got %v", expected, actual)
+		}
+	})
+
+	t.Run("replaces attributes and adds children", func(t *testing.T) {
+		n := set.Icon("check", html.Class("size-4"), svg.Width("16"), svg.Height("16"), svg.Stroke("red"),
+			svg.Title(html.Text("Done")))
+		htmltest.Equal(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24" fill="none" stroke-width="2" `+
+			`class="size-4" width="16" height="16" stroke="red"><path d="M4 12l5 5L20 6"></path><title>Done</title></svg>`, n)
+	})
+
+	t.Run("replaces attributes that are not an Attribute", func(t *testing.T) {
+		n := set.Icon("check", html.Classes{"icon": true}, html.Class("ignored"), html.Classes{"icon-sm": true})
+		htmltest.AssertContains(t, n, `svg[class="icon-sm"]`)
+	})
+
+	t.Run("doesn't change the loaded icon", func(t *testing.T) {
+		_ = set.Icon("check", svg.Fill("red"))
+		htmltest.AssertContains(t, set.Icon("check"), `svg[fill=none]`)
+	})
+
+	t.Run("prefixes ids and references to them for each render", func(t *testing.T) {
+		n := icons.IDs(html.Div(set.Icon("logo", html.Class("logo")), set.Icon("logo")))
+		s := fmt.Sprint(n)
+
+		nodes, err := html.ParseFragment(strings.NewReader(s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		logos := html.QueryAll(html.Group(nodes), "svg")
+		if len(logos) != 2 {
+			t.Fatal("expected two logos in", s)
+		}
+
+		for i, logo := range logos {
+			prefix := fmt.Sprintf("logo-%v-", i+1)
+			htmltest.AssertContains(t, logo, `linearGradient#`+prefix+`gradient`)
+			htmltest.AssertContains(t, logo, `circle#`+prefix+`dot`)
+			htmltest.AssertContains(t, logo, `rect[fill="url(#`+prefix+`gradient)"][stroke="url(#other)"]`)
+			htmltest.AssertContains(t, logo, `use[href="#`+prefix+`dot"]`)
+			htmltest.AssertContains(t, logo, `use[xlink\:href="#`+prefix+`dot"]`)
+		}
+
+		if again := fmt.Sprint(n); again != s {
+			t.Fatalf("expected the same render %v but got %v", s, again)
+		}
+	})
+
+	t.Run("prefixes ids differently for each render without a context from WithIDs", func(t *testing.T) {
+		s := fmt.Sprint(html.Div(set.Icon("logo"), set.Icon("logo")))
+
+		nodes, err := html.ParseFragment(strings.NewReader(s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, logo := range html.QueryAll(html.Group(nodes), "svg") {
+			id, _ := html.Query(logo, "linearGradient").GetAttribute("id")
+			if !strings.HasPrefix(id, "logo-") || !strings.HasSuffix(id, "-gradient") {
+				t.Fatal("gradient id is", id)
+			}
+			ids = append(ids, id)
+		}
+		if len(ids) != 2 || ids[0] == ids[1] {
+			t.Fatal("gradient ids are", ids)
+		}
+	})
+
+	t.Run("fails to render an unknown icon", func(t *testing.T) {
+		if err := set.Icon("unknown").Render(&strings.Builder{}); err == nil {
+			t.Fatal("no error")
+		}
+	})
+}
+
+func TestSet_Sprite(t *testing.T) {
+	t.Run("renders icons as uses of a symbol in the sprite sheet", func(t *testing.T) {
+		n := html.Body(set.Sprite("check", html.Class("icon"), svg.Stroke("red")), set.Sprite("check"), svg.SpriteSheet())
+		var b strings.Builder
+		if err := html.RenderCtx(svg.WithSprites(context.Background()), n, &b); err != nil {
+			t.Fatal(err)
+		}
+
+		use := `<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" ` +
+			`stroke-width="2" class="icon" stroke="red"><use href="#icon-check"></use></svg>` +
+			`<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" ` +
+			`stroke="currentColor" stroke-width="2"><use href="#icon-check"></use></svg>`
+		sheet := `<svg xmlns="http://www.w3.org/2000/svg" aria-hidden="true" style="position:absolute;width:0;height:0;overflow:hidden">` +
+			`<defs><symbol id="icon-check" viewBox="0 0 24 24"><path d="M4 12l5 5L20 6"></path></symbol></defs></svg>`
+		if expected, actual := `<body>`+use+sheet+`</body>`, b.String(); actual != expected {
+			t.Fatalf("expected %v but got %v", expected, actual)
+		}
+	})
+
+	t.Run("prefixes ids in the symbol with the symbol id", func(t *testing.T) {
+		n := html.Div(set.Sprite("logo"), set.Sprite("logo"), svg.SpriteSheet())
+		var b strings.Builder
+		if err := html.RenderCtx(svg.WithSprites(context.Background()), n, &b); err != nil {
+			t.Fatal(err)
+		}
+
+		nodes, err := html.ParseFragment(strings.NewReader(b.String()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		rendered := html.Group(nodes)
+		htmltest.AssertContains(t, rendered, `symbol#icon-logo[viewBox="0 0 32 32"] linearGradient#icon-logo-gradient`)
+		htmltest.AssertContains(t, rendered, `symbol#icon-logo rect[fill="url(#icon-logo-gradient)"]`)
+		htmltest.AssertContains(t, rendered, `symbol#icon-logo use[href="#icon-logo-dot"]`)
+		if strings.Count(b.String(), "<symbol") != 1 {
+			t.Fatal("expected one symbol in", b.String())
+		}
+	})
+
+	t.Run("fails to render an unknown icon", func(t *testing.T) {
+		if err := set.Sprite("unknown").Render(&strings.Builder{}); err == nil {
+			t.Fatal("no error")
+		}
+	})
+}
+
+func ExampleSet_Icon() {
+	fsys := fstest.MapFS{
+		"icons/plus.svg": {Data: []byte(`<svg viewBox="0 0 16 16" fill="currentColor"><path d="M7 2h2v12H7zM2 7h12v2H2z"/></svg>`)},
+	}
+	set := icons.Must(icons.Load(fsys, "icons/*.svg"))
+
+	_ = set.Icon("plus", html.Class("icon"), svg.Width("16"), svg.Height("16")).Render(os.Stdout)
+	// Output: <svg viewBox="0 0 16 16" fill="currentColor" class="icon" width="16" height="16"><path d="M7 2h2v12H7zM2 7h12v2H2z"></path></svg>
+}
@@ -0,0 +1,217 @@
+package svg
+
+import (
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/melias122/html"
+)
+
+// PathData returns a PathBuilder for building the d attribute of Path elements with commands,
+// instead of writing the path data by hand:
+//
+//	Path(PathData().MoveTo(4, 6).HorizontalBy(16).MoveTo(4, 12).HorizontalBy(16))
+//
+// Methods ending in To take absolute coordinates, and methods ending in By take coordinates relative to the current point.
+// Numbers are rounded to 3 decimals by default, see PathBuilder.Precision.
+// Commands with a number that is NaN or infinite are left out, since they aren't valid path data.
+func PathData() *PathBuilder {
+	return &PathBuilder{precision: 3}
+}
+
+// PathBuilder builds path data, as created by PathData.
+// It's a Node which renders as the d attribute, like D.
+// The path data is written as compactly as possible, like "M4 6h16M4 12h16" or "M.5-1.5l2 2 3 3".
+type PathBuilder struct {
+	b         strings.Builder
+	precision int
+	// command is the last command letter written.
+	command byte
+	// number is the last number written, empty at the start of a command.
+	number string
+}
+
+// Precision sets the number of decimals numbers are rounded to, from the next command.
+// A negative precision writes numbers exactly, with the fewest digits needed.
+func (p *PathBuilder) Precision(decimals int) *PathBuilder {
+	p.precision = decimals
+	return p
+}
+
+// MoveTo starts a new subpath at x, y.
+func (p *PathBuilder) MoveTo(x, y float64) *PathBuilder {
+	return p.write('M', x, y)
+}
+
+// MoveBy starts a new subpath at dx, dy from the current point.
+func (p *PathBuilder) MoveBy(dx, dy float64) *PathBuilder {
+	return p.write('m', dx, dy)
+}
+
+// LineTo draws a straight line to x, y.
+func (p *PathBuilder) LineTo(x, y float64) *PathBuilder {
+	return p.write('L', x, y)
+}
+
+// LineBy draws a straight line to dx, dy from the current point.
+func (p *PathBuilder) LineBy(dx, dy float64) *PathBuilder {
+	return p.write('l', dx, dy)
+}
+
+// HorizontalTo draws a horizontal line to x.
+func (p *PathBuilder) HorizontalTo(x float64) *PathBuilder {
+	return p.write('H', x)
+}
+
+// HorizontalBy draws a horizontal line dx from the current point.
+func (p *PathBuilder) HorizontalBy(dx float64) *PathBuilder {
+	return p.write('h', dx)
+}
+
+// VerticalTo draws a vertical line to y.
+func (p *PathBuilder) VerticalTo(y float64) *PathBuilder {
+	return p.write('V', y)
+}
+
+// VerticalBy draws a vertical line dy from the current point.
+func (p *PathBuilder) VerticalBy(dy float64) *PathBuilder {
+	return p.write('v', dy)
+}
+
+// CubicTo draws a cubic Bézier curve to x, y, with the control points x1, y1 and x2, y2.
+func (p *PathBuilder) CubicTo(x1, y1, x2, y2, x, y float64) *PathBuilder {
+	return p.write('C', x1, y1, x2, y2, x, y)
+}
+
+// CubicBy is like CubicTo, with all points relative to the current point.
+func (p *PathBuilder) CubicBy(dx1, dy1, dx2, dy2, dx, dy float64) *PathBuilder {
+	return p.write('c', dx1, dy1, dx2, dy2, dx, dy)
+}
+
+// SmoothCubicTo draws a cubic Bézier curve to x, y, with the control point x2, y2.
+// The first control point is the reflection of the last control point of the previous curve.
+func (p *PathBuilder) SmoothCubicTo(x2, y2, x, y float64) *PathBuilder {
+	return p.write('S', x2, y2, x, y)
+}
+
+// SmoothCubicBy is like SmoothCubicTo, with all points relative to the current point.
+func (p *PathBuilder) SmoothCubicBy(dx2, dy2, dx, dy float64) *PathBuilder {
+	return p.write('s', dx2, dy2, dx, dy)
+}
+
+// QuadraticTo draws a quadratic Bézier curve to x, y, with the control point x1, y1.
+func (p *PathBuilder) QuadraticTo(x1, y1, x, y float64) *PathBuilder {
+	return p.write('Q', x1, y1, x, y)
+}
+
+// QuadraticBy is like QuadraticTo, with all points relative to the current point.
+func (p *PathBuilder) QuadraticBy(dx1, dy1, dx, dy float64) *PathBuilder {
+	return p.write('q', dx1, dy1, dx, dy)
+}
+
+// SmoothQuadraticTo draws a quadratic Bézier curve to x, y.
+// The control point is the reflection of the control point of the previous curve.
+func (p *PathBuilder) SmoothQuadraticTo(x, y float64) *PathBuilder {
+	return p.write('T', x, y)
+}
+
+// SmoothQuadraticBy is like SmoothQuadraticTo, with the point relative to the current point.
+func (p *PathBuilder) SmoothQuadraticBy(dx, dy float64) *PathBuilder {
+	return p.write('t', dx, dy)
+}
+
+// Arc draws an elliptical arc to x, y, with the radii rx and ry, and the x axis rotated by rotation degrees.
+// Of the four possible arcs, largeArc chooses the one larger than 180 degrees,
+// and sweep chooses the one drawn in the positive-angle direction.
+func (p *PathBuilder) Arc(rx, ry, rotation float64, largeArc, sweep bool, x, y float64) *PathBuilder {
+	return p.write('A', rx, ry, rotation, flag(largeArc), flag(sweep), x, y)
+}
+
+// ArcBy is like Arc, with the end point relative to the current point.
+func (p *PathBuilder) ArcBy(rx, ry, rotation float64, largeArc, sweep bool, dx, dy float64) *PathBuilder {
+	return p.write('a', rx, ry, rotation, flag(largeArc), flag(sweep), dx, dy)
+}
+
+// Close the current subpath with a straight line to its start.
+func (p *PathBuilder) Close() *PathBuilder {
+	return p.write('Z')
+}
+
+func flag(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// write a command with its arguments, unless an argument is NaN or infinite.
+// The command letter is left out if it repeats the previous one, except for moves, which would be lines then.
+func (p *PathBuilder) write(command byte, args ...float64) *PathBuilder {
+	for _, arg := range args {
+		if math.IsNaN(arg) || math.IsInf(arg, 0) {
+			return p
+		}
+	}
+
+	if command != p.command || command == 'M' || command == 'm' || command == 'Z' {
+		p.b.WriteByte(command)
+		p.command = command
+		p.number = ""
+	}
+
+	for _, arg := range args {
+		s := p.format(arg)
+		// Numbers are separated, unless the sign or decimal point of the next one ends the previous one.
+		if p.number != "" && s[0] != '-' && (s[0] != '.' || !strings.Contains(p.number, ".")) {
+			p.b.WriteByte(' ')
+		}
+		p.b.WriteString(s)
+		p.number = s
+	}
+	return p
+}
+
+// format a number with the fewest characters, like ".5" and "-1.25".
+func (p *PathBuilder) format(v float64) string {
+	if p.precision >= 0 {
+		scale := math.Pow(10, float64(p.precision))
+		v = math.Round(v*scale) / scale
+	}
+	if v == 0 {
+		// Also for negative zero.
+		return "0"
+	}
+
+	s := strconv.FormatFloat(v, 'f', -1, 64)
+	switch {
+	case strings.HasPrefix(s, "0."):
+		s = s[1:]
+	case strings.HasPrefix(s, "-0."):
+		s = "-" + s[2:]
+	}
+	return s
+}
+
+// Data returns the path data, like "M4 6h16".
+func (p *PathBuilder) Data() string {
+	return p.b.String()
+}
+
+// Render satisfies html.Node.
+func (p *PathBuilder) Render(w io.Writer) error {
+	return D(p.Data()).Render(w)
+}
+
+// Type satisfies html.nodeTypeDescriber.
+func (p *PathBuilder) Type() html.NodeType {
+	return html.AttributeType
+}
+
+// String satisfies fmt.Stringer.
+func (p *PathBuilder) String() string {
+	var b strings.Builder
+	_ = p.Render(&b)
+	return b.String()
+}
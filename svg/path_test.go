@@ -0,0 +1,65 @@
+package svg
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"github.com/melias122/html/htmltest"
+)
+
+func TestPathData(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     *PathBuilder
+		expected string
+	}{
+		{"empty", PathData(), ``},
+		{"absolute and relative lines", PathData().MoveTo(4, 6).HorizontalBy(16).MoveTo(4, 12).HorizontalBy(16),
+			`M4 6h16M4 12h16`},
+		{"leaves out repeated commands, except moves", PathData().MoveTo(1, 1).MoveTo(2, 2).LineTo(3, 3).LineTo(4, 4).Close(),
+			`M1 1M2 2L3 3 4 4Z`},
+		{"separates numbers only if needed", PathData().MoveTo(0.5, -1.5).LineBy(-2, 0.25).LineBy(0.5, 3),
+			`M.5-1.5l-2 .25.5 3`},
+		{"vertical lines", PathData().MoveTo(0, 0).VerticalTo(10).VerticalBy(-5),
+			`M0 0V10v-5`},
+		{"cubic curves", PathData().MoveTo(10, 10).CubicTo(20, 20, 40, 20, 50, 10).SmoothCubicBy(30, -10, 40, 0),
+			`M10 10C20 20 40 20 50 10s30-10 40 0`},
+		{"quadratic curves", PathData().MoveTo(10, 10).QuadraticBy(10, 10, 20, 0).SmoothQuadraticTo(50, 10).CubicBy(1, 1, 1, 1, 1, 1).SmoothCubicTo(2, 2, 3, 3).QuadraticTo(1, 2, 3, 4).SmoothQuadraticBy(1, 1),
+			`M10 10q10 10 20 0T50 10c1 1 1 1 1 1S2 2 3 3Q1 2 3 4t1 1`},
+		{"arcs", PathData().MoveTo(10, 10).Arc(5, 5, 0, false, true, 20, 10).ArcBy(5, 5, 45, true, false, -10, 0),
+			`M10 10A5 5 0 0 1 20 10a5 5 45 1 0-10 0`},
+		{"rounds to 3 decimals", PathData().MoveTo(0.1+0.2, 1.23456).LineTo(-0.0001, 2),
+			`M.3 1.235L0 2`},
+		{"precision can be changed", PathData().Precision(0).MoveTo(1.4, 1.6).Precision(-1).LineTo(1.23456, 0.1),
+			`M1 2L1.23456.1`},
+		{"relative moves and big numbers", PathData().MoveBy(1000000, -2500.5),
+			`m1000000-2500.5`},
+		{"leaves out commands with NaN and infinite numbers", PathData().MoveTo(math.NaN(), 1).MoveTo(1, 1).LineTo(math.Inf(1), 2).LineTo(2, math.Inf(-1)).LineTo(3, 3),
+			`M1 1L3 3`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := test.path.Data(); actual != test.expected {
+				t.Fatalf("expected %q but got %q", test.expected, actual)
+			}
+		})
+	}
+
+	t.Run("renders as d attribute", func(t *testing.T) {
+		htmltest.Equal(t, `<path d="M4 6h16"></path>`, Path(PathData().MoveTo(4, 6).HorizontalBy(16)))
+	})
+
+	t.Run("also works with fmt", func(t *testing.T) {
+		if s := PathData().MoveTo(1, 2).String(); s != ` d="M1 2"` {
+			t.Fatal(s)
+		}
+	})
+}
+
+func ExamplePathData() {
+	p := Path(PathData().MoveTo(4, 6).HorizontalBy(16).MoveTo(4, 12).HorizontalBy(16).MoveTo(4, 18).HorizontalBy(16))
+	_ = p.Render(os.Stdout)
+	// Output: <path d="M4 6h16M4 12h16M4 18h16"></path>
+}
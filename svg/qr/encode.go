@@ -0,0 +1,128 @@
+package qr
+
+import (
+	"strings"
+)
+
+// mode of encoding the data.
+type mode struct {
+	indicator int
+	// countBits is the length of the character count, for versions 1-9, 10-26, and 27-40.
+	countBits [3]int
+}
+
+var (
+	numericMode      = mode{0x1, [3]int{10, 12, 14}}
+	alphanumericMode = mode{0x2, [3]int{9, 11, 13}}
+	byteMode         = mode{0x4, [3]int{8, 16, 16}}
+)
+
+const alphanumericChars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+func (m mode) countBitsFor(version int) int {
+	switch {
+	case version <= 9:
+		return m.countBits[0]
+	case version <= 26:
+		return m.countBits[1]
+	default:
+		return m.countBits[2]
+	}
+}
+
+// modeFor the data, which is the most compact mode that can encode all of it.
+func modeFor(data string) mode {
+	numeric, alphanumeric := true, true
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		numeric = numeric && c >= '0' && c <= '9'
+		alphanumeric = alphanumeric && strings.IndexByte(alphanumericChars, c) >= 0
+	}
+	switch {
+	case numeric:
+		return numericMode
+	case alphanumeric:
+		return alphanumericMode
+	default:
+		return byteMode
+	}
+}
+
+// bitBuffer is a sequence of bits.
+type bitBuffer []bool
+
+// append the lowest n bits of v, from the highest to the lowest.
+func (b *bitBuffer) append(v, n int) {
+	for i := n - 1; i >= 0; i-- {
+		*b = append(*b, v>>i&1 == 1)
+	}
+}
+
+// bytes packs the bits into bytes, big endian. The length must be a multiple of 8.
+func (b bitBuffer) bytes() []byte {
+	result := make([]byte, len(b)/8)
+	for i, bit := range b {
+		if bit {
+			result[i/8] |= 1 << (7 - i%8)
+		}
+	}
+	return result
+}
+
+// segmentBits encodes the data in the mode, without the mode indicator and character count.
+func segmentBits(data string, m mode) bitBuffer {
+	var b bitBuffer
+	switch m {
+	case numericMode:
+		for i := 0; i < len(data); i += 3 {
+			group := data[i:min(i+3, len(data))]
+			v := 0
+			for _, c := range []byte(group) {
+				v = v*10 + int(c-'0')
+			}
+			b.append(v, len(group)*3+1)
+		}
+	case alphanumericMode:
+		for i := 0; i+1 < len(data); i += 2 {
+			b.append(strings.IndexByte(alphanumericChars, data[i])*45+strings.IndexByte(alphanumericChars, data[i+1]), 11)
+		}
+		if len(data)%2 == 1 {
+			b.append(strings.IndexByte(alphanumericChars, data[len(data)-1]), 6)
+		}
+	default:
+		for i := 0; i < len(data); i++ {
+			b.append(int(data[i]), 8)
+		}
+	}
+	return b
+}
+
+// dataBits encodes the data as a segment with mode indicator and character count for the version,
+// followed by the terminator and padding to fill all data codewords of the version.
+// It returns false if the data doesn't fit.
+func dataBits(data string, m mode, segment bitBuffer, version int, level Level) (bitBuffer, bool) {
+	countBits := m.countBitsFor(version)
+	capacity := dataCodewords(version, level) * 8
+	if len(data) >= 1<<countBits || 4+countBits+len(segment) > capacity {
+		return nil, false
+	}
+
+	var b bitBuffer
+	b.append(m.indicator, 4)
+	b.append(len(data), countBits)
+	b = append(b, segment...)
+
+	b.append(0, min(4, capacity-len(b)))
+	b.append(0, (8-len(b)%8)%8)
+	for pad := 0xec; len(b) < capacity; pad ^= 0xec ^ 0x11 {
+		b.append(pad, 8)
+	}
+	return b, true
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,320 @@
+package qr
+
+// matrix of modules of a symbol, indexed by y and then x.
+type matrix struct {
+	size    int
+	dark    [][]bool
+	reserve [][]bool
+}
+
+func newMatrix(version int) *matrix {
+	m := &matrix{size: version*4 + 17}
+	m.dark = make([][]bool, m.size)
+	m.reserve = make([][]bool, m.size)
+	for y := range m.dark {
+		m.dark[y] = make([]bool, m.size)
+		m.reserve[y] = make([]bool, m.size)
+	}
+	return m
+}
+
+// setFunction sets a module that is part of a function pattern, which isn't used for data or masked.
+func (m *matrix) setFunction(x, y int, dark bool) {
+	m.dark[y][x] = dark
+	m.reserve[y][x] = true
+}
+
+// drawFunctionPatterns draws the timing, finder, and alignment patterns, and version information,
+// and reserves the format information modules, which are drawn with the mask.
+func (m *matrix) drawFunctionPatterns(version int) {
+	for i := 0; i < m.size; i++ {
+		m.setFunction(6, i, i%2 == 0)
+		m.setFunction(i, 6, i%2 == 0)
+	}
+
+	m.drawFinderPattern(3, 3)
+	m.drawFinderPattern(m.size-4, 3)
+	m.drawFinderPattern(3, m.size-4)
+
+	positions := alignmentPatternPositions(version)
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			// Except where the finder patterns are.
+			if i == 0 && j == 0 || i == 0 && j == last || i == last && j == 0 {
+				continue
+			}
+			m.drawAlignmentPattern(x, y)
+		}
+	}
+
+	m.drawFormatBits(Medium, 0)
+	m.drawVersion(version)
+}
+
+// drawFinderPattern centered at x, y, with the separator around it.
+func (m *matrix) drawFinderPattern(x, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			xx, yy := x+dx, y+dy
+			if xx < 0 || xx >= m.size || yy < 0 || yy >= m.size {
+				continue
+			}
+			distance := max(abs(dx), abs(dy))
+			m.setFunction(xx, yy, distance != 2 && distance != 4)
+		}
+	}
+}
+
+// drawAlignmentPattern centered at x, y.
+func (m *matrix) drawAlignmentPattern(x, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			m.setFunction(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// alignmentPatternPositions are the x and y coordinates of the alignment pattern centers of a version.
+func alignmentPatternPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+	n := version/7 + 2
+	step := (version*8 + n*3 + 5) / (n*4 - 4) * 2
+	positions := make([]int, n)
+	positions[0] = 6
+	for i, pos := n-1, version*4+17-7; i > 0; i, pos = i-1, pos-step {
+		positions[i] = pos
+	}
+	return positions
+}
+
+// formatBits of the error correction levels.
+var formatBits = [4]int{Low: 1, Medium: 0, Quartile: 3, High: 2}
+
+// drawFormatBits draws both copies of the format information for the error correction level and mask.
+func (m *matrix) drawFormatBits(level Level, mask int) {
+	data := formatBits[level]<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+
+	bit := func(i int) bool {
+		return bits>>i&1 == 1
+	}
+
+	for i := 0; i <= 5; i++ {
+		m.setFunction(8, i, bit(i))
+	}
+	m.setFunction(8, 7, bit(6))
+	m.setFunction(8, 8, bit(7))
+	m.setFunction(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		m.setFunction(14-i, 8, bit(i))
+	}
+
+	for i := 0; i < 8; i++ {
+		m.setFunction(m.size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		m.setFunction(8, m.size-15+i, bit(i))
+	}
+	// The dark module is always dark.
+	m.setFunction(8, m.size-8, true)
+}
+
+// drawVersion draws both copies of the version information, for versions 7 and up.
+func (m *matrix) drawVersion(version int) {
+	if version < 7 {
+		return
+	}
+	rem := version
+	for i := 0; i < 12; i++ {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1f25)
+	}
+	bits := version<<12 | rem
+
+	for i := 0; i < 18; i++ {
+		dark := bits>>i&1 == 1
+		a, b := m.size-11+i%3, i/3
+		m.setFunction(a, b, dark)
+		m.setFunction(b, a, dark)
+	}
+}
+
+// drawCodewords in the zigzag order, from the bottom right in columns of two, skipping function patterns.
+func (m *matrix) drawCodewords(codewords []byte) {
+	i := 0
+	for right := m.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			// Skip the vertical timing pattern.
+			right = 5
+		}
+		for vert := 0; vert < m.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = m.size - 1 - vert
+				}
+				if m.reserve[y][x] || i >= len(codewords)*8 {
+					continue
+				}
+				m.dark[y][x] = codewords[i/8]>>(7-i%8)&1 == 1
+				i++
+			}
+		}
+	}
+}
+
+// applyMask inverts the data modules where the mask condition is true. Applying a mask twice undoes it.
+func (m *matrix) applyMask(mask int) {
+	for y := 0; y < m.size; y++ {
+		for x := 0; x < m.size; x++ {
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			m.dark[y][x] = m.dark[y][x] != (invert && !m.reserve[y][x])
+		}
+	}
+}
+
+// Penalty weights of the mask evaluation rules.
+const (
+	penaltyRun     = 3
+	penaltyBlock   = 3
+	penaltyFinder  = 40
+	penaltyBalance = 10
+)
+
+// penalty of the symbol, which is lower for symbols that are easier to scan.
+func (m *matrix) penalty() int {
+	result := 0
+
+	// Runs of five or more modules of the same color, and patterns like finder patterns, in rows and columns.
+	for _, vertical := range []bool{false, true} {
+		for a := 0; a < m.size; a++ {
+			color := false
+			run := 0
+			var history runHistory
+			for b := 0; b < m.size; b++ {
+				dark := m.dark[a][b]
+				if vertical {
+					dark = m.dark[b][a]
+				}
+				if dark == color {
+					run++
+					if run == 5 {
+						result += penaltyRun
+					} else if run > 5 {
+						result++
+					}
+					continue
+				}
+				history.add(run, m.size)
+				if !color {
+					result += history.finderPatterns() * penaltyFinder
+				}
+				color = dark
+				run = 1
+			}
+			result += history.terminate(color, run, m.size) * penaltyFinder
+		}
+	}
+
+	// Blocks of 2x2 modules of the same color.
+	for y := 0; y < m.size-1; y++ {
+		for x := 0; x < m.size-1; x++ {
+			c := m.dark[y][x]
+			if c == m.dark[y][x+1] && c == m.dark[y+1][x] && c == m.dark[y+1][x+1] {
+				result += penaltyBlock
+			}
+		}
+	}
+
+	// The ratio of dark modules, for each 5% it's away from 50%.
+	dark := 0
+	for _, row := range m.dark {
+		for _, d := range row {
+			if d {
+				dark++
+			}
+		}
+	}
+	total := m.size * m.size
+	k := (abs(dark*20-total*10)+total-1)/total - 1
+	result += k * penaltyBalance
+
+	return result
+}
+
+// runHistory is the lengths of the last seven runs of modules in a row or column, the latest first.
+type runHistory [7]int
+
+// add a run. The first run gets the light border before the symbol added.
+func (h *runHistory) add(run, size int) {
+	if h[0] == 0 {
+		run += size
+	}
+	copy(h[1:], h[:6])
+	h[0] = run
+}
+
+// finderPatterns counts the finder-like patterns of dark-light-dark-dark-dark-light-dark runs with a ratio
+// of 1:1:3:1:1, with light runs of four times the unit before or after, at the end of the history.
+func (h *runHistory) finderPatterns() int {
+	n := h[1]
+	core := n > 0 && h[2] == n && h[3] == n*3 && h[4] == n && h[5] == n
+	count := 0
+	if core && h[0] >= n*4 && h[6] >= n {
+		count++
+	}
+	if core && h[6] >= n*4 && h[0] >= n {
+		count++
+	}
+	return count
+}
+
+// terminate the row or column with the light border after the symbol, and count finder-like patterns.
+func (h *runHistory) terminate(color bool, run, size int) int {
+	if color {
+		h.add(run, size)
+		run = 0
+	}
+	run += size
+	h.add(run, size)
+	return h.finderPatterns()
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
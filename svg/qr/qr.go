@@ -0,0 +1,207 @@
+// Package qr encodes QR codes, and draws them as inline SVG.
+//
+// Data is encoded in numeric, alphanumeric, or byte mode, whichever is the most compact for all of it,
+// in the smallest version from 1 to 40 that fits, with the mask that makes the symbol easiest to scan.
+// See https://www.nayuki.io/page/creating-a-qr-code-step-by-step for how it works.
+package qr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/melias122/html"
+	"github.com/melias122/html/svg"
+)
+
+// Level of error correction, which is the share of the symbol that can be damaged while it can still be scanned.
+type Level int
+
+const (
+	Low      Level = iota // About 7%.
+	Medium                // About 15%.
+	Quartile              // About 25%.
+	High                  // About 30%.
+)
+
+// ErrTooLong is returned by Encode if the data doesn't fit in any of the allowed versions.
+var ErrTooLong = errors.New("qr: data too long")
+
+// Option configures Encode.
+type Option func(*options)
+
+type options struct {
+	level                  Level
+	minVersion, maxVersion int
+	mask                   int
+	border                 int
+	dark, light            string
+}
+
+// ErrorCorrection sets the error correction level, which is Medium by default.
+func ErrorCorrection(level Level) Option {
+	return func(o *options) {
+		o.level = level
+	}
+}
+
+// Versions sets the range of versions to choose the smallest one that fits from, which is 1 to 40 by default.
+// A symbol of version v has 4v+17 modules on each side.
+func Versions(min, max int) Option {
+	return func(o *options) {
+		o.minVersion = min
+		o.maxVersion = max
+	}
+}
+
+// Mask sets the mask pattern from 0 to 7, instead of choosing the one with the lowest penalty.
+func Mask(mask int) Option {
+	return func(o *options) {
+		o.mask = mask
+	}
+}
+
+// Border sets the width of the light border around the symbol in modules, which is 4 by default,
+// as the QR code specification requires.
+func Border(modules int) Option {
+	return func(o *options) {
+		o.border = modules
+	}
+}
+
+// Colors sets the colors of the dark and light modules, which are black and white by default.
+// An empty light color leaves out the background, so it's transparent.
+func Colors(dark, light string) Option {
+	return func(o *options) {
+		o.dark = dark
+		o.light = light
+	}
+}
+
+// Code is an encoded QR code symbol.
+type Code struct {
+	// Version from 1 to 40.
+	Version int
+	// Level of error correction.
+	Level Level
+	// Mask pattern from 0 to 7.
+	Mask   int
+	m      *matrix
+	border int
+	dark   string
+	light  string
+}
+
+// Encode the data in a QR code.
+func Encode(data string, opts ...Option) (*Code, error) {
+	o := options{level: Medium, minVersion: 1, maxVersion: 40, mask: -1, border: 4, dark: "#000", light: "#fff"}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.minVersion < 1 || o.maxVersion > 40 || o.minVersion > o.maxVersion {
+		return nil, fmt.Errorf("qr: invalid version range %v to %v", o.minVersion, o.maxVersion)
+	}
+	if o.level < Low || o.level > High {
+		return nil, fmt.Errorf("qr: invalid error correction level %v", o.level)
+	}
+	if o.mask < -1 || o.mask > 7 {
+		return nil, fmt.Errorf("qr: invalid mask %v", o.mask)
+	}
+	if o.border < 0 {
+		return nil, fmt.Errorf("qr: invalid border %v", o.border)
+	}
+
+	m := modeFor(data)
+	segment := segmentBits(data, m)
+	version := o.minVersion
+	bits, ok := dataBits(data, m, segment, version, o.level)
+	for !ok {
+		if version == o.maxVersion {
+			return nil, ErrTooLong
+		}
+		version++
+		bits, ok = dataBits(data, m, segment, version, o.level)
+	}
+
+	c := &Code{Version: version, Level: o.level, Mask: o.mask, border: o.border, dark: o.dark, light: o.light}
+	c.m = newMatrix(version)
+	c.m.drawFunctionPatterns(version)
+	c.m.drawCodewords(addErrorCorrection(bits.bytes(), version, o.level))
+
+	if c.Mask < 0 {
+		lowest := -1
+		for mask := 0; mask < 8; mask++ {
+			c.m.applyMask(mask)
+			c.m.drawFormatBits(o.level, mask)
+			if penalty := c.m.penalty(); lowest < 0 || penalty < lowest {
+				lowest = penalty
+				c.Mask = mask
+			}
+			c.m.applyMask(mask)
+		}
+	}
+	c.m.applyMask(c.Mask)
+	c.m.drawFormatBits(o.level, c.Mask)
+
+	return c, nil
+}
+
+// Size of the symbol in modules on each side, without the border.
+func (c *Code) Size() int {
+	return c.m.size
+}
+
+// Dark returns whether the module at x, y is dark, with 0, 0 at the top left, without the border.
+func (c *Code) Dark(x, y int) bool {
+	return c.m.dark[y][x]
+}
+
+// SVG returns an svg element with the symbol, with each module being one unit in the viewBox.
+// The dark modules are drawn as a single path of horizontal runs.
+// Children are added to the svg element, for example to set the width and height.
+func (c *Code) SVG(children ...html.Node) html.Node {
+	size := strconv.Itoa(c.m.size + 2*c.border)
+
+	d := svg.PathData()
+	// Each run is a subpath starting with a move relative to the start of the previous one,
+	// which is where the previous subpath ends after closing it.
+	px, py := 0, 0
+	first := true
+	for y := 0; y < c.m.size; y++ {
+		for x := 0; x < c.m.size; {
+			if !c.m.dark[y][x] {
+				x++
+				continue
+			}
+			run := 1
+			for x+run < c.m.size && c.m.dark[y][x+run] {
+				run++
+			}
+
+			if first {
+				d.MoveTo(float64(x+c.border), float64(y+c.border))
+				first = false
+			} else {
+				d.MoveBy(float64(x-px), float64(y-py))
+			}
+			d.HorizontalBy(float64(run)).VerticalBy(1).HorizontalBy(float64(-run)).Close()
+			px, py = x, y
+			x += run
+		}
+	}
+
+	return svg.SVG(svg.ViewBox("0 0 "+size+" "+size), svg.ShapeRendering("crispEdges"),
+		html.If(c.light != "", svg.Rect(svg.Width("100%"), svg.Height("100%"), svg.Fill(c.light))),
+		svg.Path(svg.Fill(c.dark), d),
+		html.Group(children),
+	)
+}
+
+// SVG encodes the data in a QR code, and returns it drawn as an svg element. See Encode and Code.SVG.
+func SVG(data string, opts ...Option) (html.Node, error) {
+	c, err := Encode(data, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return c.SVG(), nil
+}
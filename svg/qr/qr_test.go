@@ -0,0 +1,359 @@
+package qr
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/melias122/html"
+	"github.com/melias122/html/htmltest"
+)
+
+func TestAddErrorCorrection(t *testing.T) {
+	t.Run("adds error correction codewords for HELLO WORLD in version 1-M", func(t *testing.T) {
+		bits, ok := dataBits("HELLO WORLD", alphanumericMode, segmentBits("HELLO WORLD", alphanumericMode), 1, Medium)
+		if !ok {
+			t.Fatal("doesn't fit")
+		}
+		data := bits.bytes()
+		if fmt.Sprint(data) != "[32 91 11 120 209 114 220 77 67 64 236 17 236 17 236 17]" {
+			t.Fatal("data codewords are", data)
+		}
+
+		codewords := addErrorCorrection(data, 1, Medium)
+		if ecc := codewords[len(data):]; fmt.Sprint(ecc) != "[196 35 39 119 235 215 231 226 93 23]" {
+			t.Fatal("error correction codewords are", ecc)
+		}
+	})
+}
+
+func TestDataCodewords(t *testing.T) {
+	// From the capacity table of the QR code specification.
+	tests := []struct {
+		version  int
+		expected [4]int
+	}{
+		{1, [4]int{19, 16, 13, 9}},
+		{2, [4]int{34, 28, 22, 16}},
+		{5, [4]int{108, 86, 62, 46}},
+		{7, [4]int{156, 124, 88, 66}},
+		{10, [4]int{274, 216, 154, 122}},
+		{40, [4]int{2956, 2334, 1666, 1276}},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprint("version ", test.version), func(t *testing.T) {
+			for level := Low; level <= High; level++ {
+				if actual := dataCodewords(test.version, level); actual != test.expected[level] {
+					t.Fatalf("expected %v for level %v, but got %v", test.expected[level], level, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestAlignmentPatternPositions(t *testing.T) {
+	tests := map[int]string{
+		1:  "[]",
+		2:  "[6 18]",
+		7:  "[6 22 38]",
+		32: "[6 34 60 86 112 138]",
+		40: "[6 30 58 86 114 142 170]",
+	}
+
+	for version, expected := range tests {
+		if actual := fmt.Sprint(alignmentPatternPositions(version)); actual != expected {
+			t.Fatalf("expected %v for version %v, but got %v", expected, version, actual)
+		}
+	}
+}
+
+func TestDrawFormatBits(t *testing.T) {
+	tests := []struct {
+		level    Level
+		mask     int
+		expected string
+	}{
+		{Low, 0, "111011111000100"},
+		{Low, 4, "110011000101111"},
+		{Medium, 0, "101010000010010"},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.level, test.mask), func(t *testing.T) {
+			m := newMatrix(1)
+			m.drawFormatBits(test.level, test.mask)
+			if actual := readFormatBits(m); actual != test.expected {
+				t.Fatalf("expected %v but got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDrawVersion(t *testing.T) {
+	m := newMatrix(7)
+	m.drawVersion(7)
+	var bits int
+	for i := 17; i >= 0; i-- {
+		bits <<= 1
+		if m.dark[i/3][m.size-11+i%3] {
+			bits |= 1
+		}
+	}
+	if bits != 0x07c94 {
+		t.Fatalf("expected version bits %b but got %b", 0x07c94, bits)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    []Option
+		version int
+	}{
+		{"numeric", "01234567", nil, 1},
+		{"most numeric in version 1-L", strings.Repeat("7", 41), []Option{ErrorCorrection(Low)}, 1},
+		{"more numeric in version 1-L", strings.Repeat("7", 42), []Option{ErrorCorrection(Low)}, 2},
+		{"alphanumeric", "HELLO WORLD", nil, 1},
+		{"most alphanumeric in version 1-L", strings.Repeat("A", 25), []Option{ErrorCorrection(Low)}, 1},
+		{"byte", "Hello, world! 👋", nil, 2},
+		{"most bytes in version 1-L", strings.Repeat("a", 17), []Option{ErrorCorrection(Low)}, 1},
+		{"more bytes in version 1-L", strings.Repeat("a", 18), []Option{ErrorCorrection(Low)}, 2},
+		{"url", "https://example.com/tickets/1234?seat=12A", []Option{ErrorCorrection(High)}, 5},
+		{"minimum version", "a", []Option{Versions(7, 40)}, 7},
+		{"forced mask", "mask", []Option{Mask(5)}, 1},
+		{"empty", "", nil, 1},
+		{"version 40", strings.Repeat("x", 2953), []Option{ErrorCorrection(Low)}, 40},
+		{"large numeric", strings.Repeat("0123456789", 300), []Option{ErrorCorrection(Quartile)}, 35},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := Encode(test.data, test.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.Version != test.version {
+				t.Fatalf("expected version %v but got %v", test.version, c.Version)
+			}
+			if c.Size() != c.Version*4+17 {
+				t.Fatal("size is", c.Size())
+			}
+			if actual := decode(t, c); actual != test.data {
+				t.Fatalf("expected to decode %q but got %q", test.data, actual)
+			}
+		})
+	}
+
+	t.Run("chooses the same mask for the same data", func(t *testing.T) {
+		a, _ := Encode("deterministic")
+		b, _ := Encode("deterministic")
+		if a.Mask != b.Mask || fmt.Sprint(a.SVG()) != fmt.Sprint(b.SVG()) {
+			t.Fatal("differs")
+		}
+	})
+
+	t.Run("returns ErrTooLong if data doesn't fit", func(t *testing.T) {
+		if _, err := Encode(strings.Repeat("x", 1300), ErrorCorrection(High)); !errors.Is(err, ErrTooLong) {
+			t.Fatal("error is", err)
+		}
+		if _, err := Encode(strings.Repeat("x", 20), ErrorCorrection(Low), Versions(1, 1)); !errors.Is(err, ErrTooLong) {
+			t.Fatal("error is", err)
+		}
+	})
+
+	t.Run("returns error for invalid options", func(t *testing.T) {
+		for _, opt := range []Option{Versions(0, 40), Versions(1, 41), Versions(10, 9), ErrorCorrection(4), Mask(8), Border(-1)} {
+			if _, err := Encode("a", opt); err == nil || errors.Is(err, ErrTooLong) {
+				t.Fatal("error is", err)
+			}
+		}
+	})
+}
+
+func TestCode_SVG(t *testing.T) {
+	t.Run("draws dark modules as a single path", func(t *testing.T) {
+		n, err := SVG("HELLO WORLD", Border(2), Colors("currentColor", ""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		htmltest.AssertContains(t, n, `svg[viewBox="0 0 25 25"][shape-rendering=crispEdges] > path[fill=currentColor]`)
+		if len(html.QueryAll(n, "path")) != 1 || html.Query(n, "rect") != nil {
+			t.Fatal("expected a single path without background")
+		}
+
+		d, _ := html.Query(n, "path").GetAttribute("d")
+		// The first row starts with the 7 dark modules of the top left finder pattern.
+		if !strings.HasPrefix(d, "M2 2h7v1h-7Zm") {
+			t.Fatal("path is", d)
+		}
+	})
+
+	t.Run("has a background rect and children", func(t *testing.T) {
+		c, err := Encode("https://example.com", Mask(0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := c.SVG(html.Width("128"), html.Class("qr"))
+		htmltest.AssertContains(t, n, `svg.qr[width="128"] > rect[fill="#fff"] + path[fill="#000"]`)
+	})
+}
+
+// readFormatBits reads the first copy of the format information, from the highest bit.
+func readFormatBits(m *matrix) string {
+	var positions [][2]int
+	for i := 0; i <= 5; i++ {
+		positions = append(positions, [2]int{8, i})
+	}
+	positions = append(positions, [2]int{8, 7}, [2]int{8, 8}, [2]int{7, 8})
+	for i := 9; i < 15; i++ {
+		positions = append(positions, [2]int{14 - i, 8})
+	}
+
+	var b strings.Builder
+	for i := 14; i >= 0; i-- {
+		if m.dark[positions[i][1]][positions[i][0]] {
+			b.WriteByte('1')
+		} else {
+			b.WriteByte('0')
+		}
+	}
+	return b.String()
+}
+
+// decode the data of the code, like a scanner would, to check that it's encoded correctly.
+func decode(t *testing.T, c *Code) string {
+	t.Helper()
+
+	// Find the level and mask from the format information.
+	format := readFormatBits(c.m)
+	level, mask := Level(-1), -1
+	for l := Low; l <= High; l++ {
+		for k := 0; k < 8; k++ {
+			m := newMatrix(1)
+			m.drawFormatBits(l, k)
+			if readFormatBits(m) == format {
+				level, mask = l, k
+			}
+		}
+	}
+	if level != c.Level || mask != c.Mask {
+		t.Fatalf("format information is for level %v and mask %v", level, mask)
+	}
+
+	// Unmask the data modules, and read the codewords in the zigzag order.
+	m := newMatrix(c.Version)
+	m.drawFunctionPatterns(c.Version)
+	for y := range m.dark {
+		copy(m.dark[y], c.m.dark[y])
+	}
+	m.applyMask(mask)
+
+	var bits bitBuffer
+	for right := m.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < m.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vert
+				if (right+1)&2 == 0 {
+					y = m.size - 1 - vert
+				}
+				if !m.reserve[y][x] {
+					bits = append(bits, m.dark[y][x])
+				}
+			}
+		}
+	}
+	codewords := bits[:len(bits)/8*8].bytes()
+
+	// Deinterleave the blocks, and check their error correction codewords.
+	blocks := errorCorrectionBlocks[level][c.Version]
+	eccLen := eccCodewordsPerBlock[level][c.Version]
+	shortBlocks := blocks - len(codewords)%blocks
+	shortDataLen := len(codewords)/blocks - eccLen
+	split := make([][]byte, blocks)
+	i := 0
+	for k := 0; k < shortDataLen+1; k++ {
+		for j := range split {
+			if k < shortDataLen || j >= shortBlocks {
+				split[j] = append(split[j], codewords[i])
+				i++
+			}
+		}
+	}
+	divisor := reedSolomonDivisor(eccLen)
+	var data []byte
+	for j := range split {
+		var ecc []byte
+		for k := 0; k < eccLen; k++ {
+			ecc = append(ecc, codewords[i+k*blocks+j])
+		}
+		if !bytes.Equal(reedSolomonRemainder(split[j], divisor), ecc) {
+			t.Fatalf("error correction codewords of block %v don't match", j)
+		}
+		data = append(data, split[j]...)
+	}
+
+	// Read the segment.
+	var dataBits bitBuffer
+	for _, b := range data {
+		dataBits.append(int(b), 8)
+	}
+	read := func(n int) int {
+		v := 0
+		for k := 0; k < n; k++ {
+			v <<= 1
+			if dataBits[k] {
+				v |= 1
+			}
+		}
+		dataBits = dataBits[n:]
+		return v
+	}
+
+	var md mode
+	switch indicator := read(4); indicator {
+	case numericMode.indicator:
+		md = numericMode
+	case alphanumericMode.indicator:
+		md = alphanumericMode
+	case byteMode.indicator:
+		md = byteMode
+	default:
+		t.Fatalf("unknown mode %v", indicator)
+	}
+	count := read(md.countBitsFor(c.Version))
+
+	var b strings.Builder
+	switch md {
+	case numericMode:
+		for ; count >= 3; count -= 3 {
+			fmt.Fprintf(&b, "%03d", read(10))
+		}
+		switch count {
+		case 2:
+			fmt.Fprintf(&b, "%02d", read(7))
+		case 1:
+			fmt.Fprintf(&b, "%d", read(4))
+		}
+	case alphanumericMode:
+		for ; count >= 2; count -= 2 {
+			v := read(11)
+			b.WriteByte(alphanumericChars[v/45])
+			b.WriteByte(alphanumericChars[v%45])
+		}
+		if count == 1 {
+			b.WriteByte(alphanumericChars[read(6)])
+		}
+	default:
+		for ; count > 0; count-- {
+			b.WriteByte(byte(read(8)))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,126 @@
+package svg
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/melias122/html"
+)
+
+type spritesContextKey struct{}
+
+// sprites are the symbols registered by Sprite Nodes rendered with a context from WithSprites.
+type sprites struct {
+	mu      sync.Mutex
+	symbols map[string]html.Node
+	// rendered symbols, by SpriteSheet or inline.
+	rendered map[string]bool
+	// sheet is whether a SpriteSheet has been rendered.
+	sheet bool
+}
+
+// WithSprites returns a copy of ctx in which Sprite Nodes register their symbols, to render them just once
+// with SpriteSheet. See also Sprites.
+func WithSprites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, spritesContextKey{}, newSprites())
+}
+
+// Sprites renders the Node n with a render context like from WithSprites, so a page can show Sprite Nodes
+// with a SpriteSheet without middleware:
+//
+//	html.Body(svg.Sprites(html.Group([]html.Node{content, svg.SpriteSheet()})))
+//
+// The symbols are registered for each render of n, or in the context from WithSprites if there is one already.
+// Like in html.WithValue, n may be nil or a Group, and Nodes of AttributeType are not rendered.
+func Sprites(n html.Node) html.Node {
+	return html.ContextNodeFunc(func(ctx context.Context, w io.Writer) error {
+		s, ok := ctx.Value(spritesContextKey{}).(*sprites)
+		if !ok {
+			s = newSprites()
+		}
+		return html.RenderCtx(ctx, html.WithValue(spritesContextKey{}, s, n), w)
+	})
+}
+
+func newSprites() *sprites {
+	return &sprites{symbols: map[string]html.Node{}, rendered: map[string]bool{}}
+}
+
+// Sprite returns an svg element that shows the symbol with the id through a use element.
+// The symbol Node is the content of the symbol element, such as a ViewBox and paths,
+// and the children are added to the svg element, such as Class, Width, and Height.
+//
+// If it's rendered with a context from WithSprites or inside Sprites, the symbol is registered by its id,
+// and rendered once by SpriteSheet, no matter how many Sprite Nodes with that id there are. Symbols registered after a SpriteSheet
+// has been rendered, such as by Deferred Nodes, are rendered by the first Sprite with that id instead.
+//
+// Otherwise, each Sprite renders the symbol inside its svg element.
+func Sprite(id string, symbol html.Node, children ...html.Node) html.Node {
+	return html.ContextNodeFunc(func(ctx context.Context, w io.Writer) error {
+		inline := true
+		if s, ok := ctx.Value(spritesContextKey{}).(*sprites); ok {
+			inline = s.register(id, symbol)
+		}
+		return html.RenderCtx(ctx, SVG(
+			html.Group(children),
+			html.If(inline, Symbol(ID(id), symbol)),
+			Use(Href("#"+id)),
+		), w)
+	})
+}
+
+// register the symbol, if there isn't one with the id already.
+// It returns whether the symbol must be rendered inline, because a SpriteSheet has already been rendered.
+func (s *sprites) register(id string, symbol html.Node) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.symbols[id]; !ok {
+		s.symbols[id] = symbol
+	}
+	if s.sheet && !s.rendered[id] {
+		s.rendered[id] = true
+		return true
+	}
+	return false
+}
+
+// SpriteSheet returns a Node that renders the symbols registered by Sprite Nodes in a hidden svg element,
+// sorted by id. Each symbol is rendered once, even with more than one SpriteSheet.
+// Place it after the Sprite Nodes, such as at the end of the body, and render with a context from WithSprites
+// or inside Sprites.
+// Otherwise, it renders nothing.
+func SpriteSheet() html.Node {
+	return html.ContextNodeFunc(func(ctx context.Context, w io.Writer) error {
+		s, ok := ctx.Value(spritesContextKey{}).(*sprites)
+		if !ok {
+			return nil
+		}
+
+		s.mu.Lock()
+		s.sheet = true
+		var ids []string
+		for id := range s.symbols {
+			if !s.rendered[id] {
+				ids = append(ids, id)
+				s.rendered[id] = true
+			}
+		}
+		var symbols []html.Node
+		sort.Strings(ids)
+		for _, id := range ids {
+			symbols = append(symbols, Symbol(ID(id), s.symbols[id]))
+		}
+		s.mu.Unlock()
+
+		if len(symbols) == 0 {
+			return nil
+		}
+		return html.RenderCtx(ctx, SVG(
+			html.Aria("hidden", "true"),
+			StyleAttr("position:absolute;width:0;height:0;overflow:hidden"),
+			Defs(html.Group(symbols)),
+		), w)
+	})
+}
@@ -0,0 +1,109 @@
+package svg
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/melias122/html"
+	"github.com/melias122/html/htmltest"
+)
+
+func TestSprite(t *testing.T) {
+	check := Sprite("check", html.Group([]html.Node{ViewBox("0 0 24 24"), Path(D("M4 12l5 5L20 6"))}), html.Class("icon"))
+	dot := Sprite("dot", Circle(R("4")))
+
+	t.Run("renders the symbol inline without a context from WithSprites", func(t *testing.T) {
+		htmltest.Equal(t, `<svg xmlns="http://www.w3.org/2000/svg" class="icon"><symbol id="check" viewBox="0 0 24 24">`+
+			`<path d="M4 12l5 5L20 6"></path></symbol><use href="#check"></use></svg>`, check)
+	})
+
+	t.Run("renders each symbol once in the sprite sheet, sorted by id", func(t *testing.T) {
+		var b strings.Builder
+		n := html.Body(check, dot, check, html.Div(check), SpriteSheet())
+		if err := html.RenderCtx(WithSprites(context.Background()), n, &b); err != nil {
+			t.Fatal(err)
+		}
+
+		use := `<svg xmlns="http://www.w3.org/2000/svg" class="icon"><use href="#check"></use></svg>`
+		expected := `<body>` + use + `<svg xmlns="http://www.w3.org/2000/svg"><use href="#dot"></use></svg>` + use +
+			`<div>` + use + `</div>` +
+			`<svg xmlns="http://www.w3.org/2000/svg" aria-hidden="true" style="position:absolute;width:0;height:0;overflow:hidden"><defs>` +
+			`<symbol id="check" viewBox="0 0 24 24"><path d="M4 12l5 5L20 6"></path></symbol>` +
+			`<symbol id="dot"><circle r="4"></circle></symbol></defs></svg></body>`
+		if actual := b.String(); actual != expected {
+			t.Fatalf("expected %v but got %v", expected, actual)
+		}
+	})
+
+	t.Run("renders symbols registered after the sprite sheet inline once", func(t *testing.T) {
+		var b strings.Builder
+		n := html.Div(check, SpriteSheet(), dot, dot, check, SpriteSheet())
+		if err := html.RenderCtx(WithSprites(context.Background()), n, &b); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Count(b.String(), `<symbol id="dot">`) != 1 || strings.Count(b.String(), `<symbol id="check"`) != 1 {
+			t.Fatal("symbols are not rendered once in", b.String())
+		}
+		if !strings.HasPrefix(b.String()[strings.Index(b.String(), "</defs>"):], `</defs></svg><svg xmlns="http://www.w3.org/2000/svg"><symbol id="dot">`) {
+			t.Fatal("dot symbol is not rendered by the first sprite after the sheet in", b.String())
+		}
+	})
+
+	t.Run("registers symbols for each render inside Sprites", func(t *testing.T) {
+		n := html.Body(Sprites(html.Group([]html.Node{check, html.Div(dot), SpriteSheet()})))
+		expected := `<body><svg xmlns="http://www.w3.org/2000/svg" class="icon"><use href="#check"></use></svg>` +
+			`<div><svg xmlns="http://www.w3.org/2000/svg"><use href="#dot"></use></svg></div>` +
+			`<svg xmlns="http://www.w3.org/2000/svg" aria-hidden="true" style="position:absolute;width:0;height:0;overflow:hidden"><defs>` +
+			`<symbol id="check" viewBox="0 0 24 24"><path d="M4 12l5 5L20 6"></path></symbol>` +
+			`<symbol id="dot"><circle r="4"></circle></symbol></defs></svg></body>`
+		htmltest.Equal(t, expected, n)
+		htmltest.Equal(t, expected, n)
+	})
+
+	t.Run("uses the symbols of a context from WithSprites inside Sprites", func(t *testing.T) {
+		var b strings.Builder
+		n := html.Div(Sprites(check), Sprites(check), SpriteSheet())
+		if err := html.RenderCtx(WithSprites(context.Background()), n, &b); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Count(b.String(), `<symbol id="check"`) != 1 || !strings.HasSuffix(b.String(), `</symbol></defs></svg></div>`) {
+			t.Fatal("check symbol is not rendered once in the sprite sheet in", b.String())
+		}
+	})
+
+	t.Run("sprite sheet renders nothing without symbols or a context from WithSprites", func(t *testing.T) {
+		htmltest.Equal(t, ``, SpriteSheet())
+
+		var b strings.Builder
+		if err := html.RenderCtx(WithSprites(context.Background()), SpriteSheet(), &b); err != nil || b.Len() != 0 {
+			t.Fatal("rendered", b.String(), err)
+		}
+	})
+}
+
+func ExampleSpriteSheet() {
+	star := func() html.Node {
+		return Sprite("star", html.Group([]html.Node{ViewBox("0 0 2 2"), Circle(Cx("1"), Cy("1"), R("1"))}), Width("16"))
+	}
+
+	page := html.Body(star(), star(), SpriteSheet())
+	_ = html.RenderCtx(WithSprites(context.Background()), html.Indent(page, "  "), os.Stdout)
+	// Output:
+	// <body>
+	//   <svg xmlns="http://www.w3.org/2000/svg" width="16">
+	//     <use href="#star"></use>
+	//   </svg>
+	//   <svg xmlns="http://www.w3.org/2000/svg" width="16">
+	//     <use href="#star"></use>
+	//   </svg>
+	//   <svg xmlns="http://www.w3.org/2000/svg" aria-hidden="true" style="position:absolute;width:0;height:0;overflow:hidden">
+	//     <defs>
+	//       <symbol id="star" viewBox="0 0 2 2">
+	//         <circle cx="1" cy="1" r="1"></circle>
+	//       </symbol>
+	//     </defs>
+	//   </svg>
+	// </body>
+}
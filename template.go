@@ -0,0 +1,119 @@
+package html
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// Template executes the template with the given name from t with data, when rendered.
+// This is useful for embedding html/template templates in Node trees, for example while migrating from them.
+// Errors from executing the template are returned from Render.
+func Template(t *template.Template, name string, data interface{}) Node {
+	return &templateNode{t: t, name: name, data: data}
+}
+
+type templateNode struct {
+	t    *template.Template
+	name string
+	data interface{}
+}
+
+// Render satisfies Node.
+func (n *templateNode) Render(w io.Writer) error {
+	return n.t.ExecuteTemplate(w, n.name, n.data)
+}
+
+// Type satisfies nodeTypeDescriber.
+func (n *templateNode) Type() NodeType {
+	return ElementType
+}
+
+// String satisfies fmt.Stringer.
+func (n *templateNode) String() string {
+	var b strings.Builder
+	_ = n.Render(&b)
+	return b.String()
+}
+
+// ToHTML renders the Node as a template.HTML value, so it's not escaped again when used in html/template templates.
+// It can be used as a template function to render Nodes in template data, like template.FuncMap{"node": ToHTML}.
+// A nil Node is the empty string, and a Group is rendered child by child. See also ToHTMLContext.
+func ToHTML(n Node) (template.HTML, error) {
+	return ToHTMLContext(context.Background(), n)
+}
+
+// ToHTMLContext is like ToHTML, but renders the Node with the render context ctx,
+// so it can use values from it, like in FromContext.
+func ToHTMLContext(ctx context.Context, n Node) (template.HTML, error) {
+	var b strings.Builder
+	w := &statefulWriter{w: &b}
+	renderChild(ctx, w, n, ElementType)
+	if w.err != nil {
+		return "", w.err
+	}
+	return template.HTML(b.String()), nil
+}
+
+var (
+	nodeType  = reflect.TypeOf((*Node)(nil)).Elem()
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
+	htmlType  = reflect.TypeOf(template.HTML(""))
+)
+
+// TemplateFunc wraps a function returning a Node, like a component, in a function with the same parameters
+// returning template.HTML and an error, so it can be called from html/template templates.
+// The function may also return a Node and an error.
+// Functions returning Nodes that are only passed as arguments to other functions, like attributes,
+// can be added to a template.FuncMap without wrapping.
+// TemplateFunc panics if fn is not such a function.
+func TemplateFunc(fn interface{}) interface{} {
+	v := reflect.ValueOf(fn)
+	t := v.Type()
+	if t.Kind() != reflect.Func || t.NumOut() < 1 || t.NumOut() > 2 || !t.Out(0).Implements(nodeType) ||
+		t.NumOut() == 2 && t.Out(1) != errorType {
+		panic(fmt.Sprintf("html: TemplateFunc needs a function returning a Node, and optionally an error, but got %v", t))
+	}
+
+	in := make([]reflect.Type, t.NumIn())
+	for i := range in {
+		in[i] = t.In(i)
+	}
+	wrapped := reflect.FuncOf(in, []reflect.Type{htmlType, errorType}, t.IsVariadic())
+
+	return reflect.MakeFunc(wrapped, func(args []reflect.Value) []reflect.Value {
+		var out []reflect.Value
+		if t.IsVariadic() {
+			out = v.CallSlice(args)
+		} else {
+			out = v.Call(args)
+		}
+
+		if len(out) == 2 && !out[1].IsNil() {
+			return []reflect.Value{reflect.ValueOf(template.HTML("")), out[1]}
+		}
+
+		var h template.HTML
+		var err error
+		if n, ok := out[0].Interface().(Node); ok && n != nil {
+			h, err = ToHTML(n)
+		}
+		errValue := reflect.Zero(errorType)
+		if err != nil {
+			errValue = reflect.ValueOf(&err).Elem()
+		}
+		return []reflect.Value{reflect.ValueOf(h), errValue}
+	}).Interface()
+}
+
+// TemplateFuncs returns a template.FuncMap with TemplateFunc applied to each function.
+func TemplateFuncs(funcs map[string]interface{}) template.FuncMap {
+	m := template.FuncMap{}
+	for name, fn := range funcs {
+		m[name] = TemplateFunc(fn)
+	}
+	return m
+}
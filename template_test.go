@@ -0,0 +1,147 @@
+package html
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestTemplate(t *testing.T) {
+	tmpl := template.Must(template.New("").Parse(`{{define "greeting"}}<p>Hello, {{.}}!</p>{{end}}` +
+		`{{define "fail"}}{{.Fail}}{{end}}`))
+
+	t.Run("executes the template with data inside an element", func(t *testing.T) {
+		n := Div(Template(tmpl, "greeting", "<party>"))
+		Equal(t, `<div><p>Hello, &lt;party&gt;!</p></div>`, n)
+	})
+
+	t.Run("returns execution errors from render", func(t *testing.T) {
+		err := Div(Template(tmpl, "fail", "hat")).Render(&strings.Builder{})
+		Error(t, err)
+		if !strings.Contains(err.Error(), "can't evaluate field Fail") {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("returns an error for an unknown template", func(t *testing.T) {
+		Error(t, Template(tmpl, "unknown", nil).Render(&strings.Builder{}))
+	})
+}
+
+func TestToHTML(t *testing.T) {
+	t.Run("renders a node unescaped in a template", func(t *testing.T) {
+		tmpl := template.Must(template.New("").Funcs(template.FuncMap{"node": ToHTML}).Parse(`<main>{{node .}}</main>`))
+
+		var b strings.Builder
+		if err := tmpl.Execute(&b, P(Class("hat"), Text("<party>"))); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != `<main><p class="hat">&lt;party&gt;</p></main>` {
+			t.Fatal(b.String())
+		}
+	})
+
+	t.Run("returns render errors", func(t *testing.T) {
+		_, err := ToHTML(NodeFunc(func(w io.Writer) error { return errors.New("don't want to") }))
+		Error(t, err)
+	})
+
+	t.Run("renders a group child by child", func(t *testing.T) {
+		h, err := ToHTML(Group([]Node{P(Text("a")), nil, Text("b")}))
+		if err != nil || h != "<p>a</p>b" {
+			t.Fatal(h, err)
+		}
+	})
+
+	t.Run("renders nil as the empty string", func(t *testing.T) {
+		h, err := ToHTML(nil)
+		if err != nil || h != "" {
+			t.Fatal(h, err)
+		}
+	})
+
+	t.Run("renders with the render context", func(t *testing.T) {
+		type key struct{}
+		n := FromContext(func(ctx context.Context) Node {
+			return Text(ctx.Value(key{}).(string))
+		})
+		h, err := ToHTMLContext(context.WithValue(context.Background(), key{}, "hat"), n)
+		if err != nil || h != "hat" {
+			t.Fatal(h, err)
+		}
+	})
+}
+
+func TestTemplateFunc(t *testing.T) {
+	button := func(label string, children ...Node) Node {
+		return Button(Group(children), Text(label))
+	}
+	failing := func() (Node, error) {
+		return nil, errors.New("don't want to")
+	}
+	items := func(names ...string) Node {
+		return Group(Map(names, func(name string) Node { return Li(Text(name)) }))
+	}
+
+	tmpl := template.Must(template.New("").Funcs(TemplateFuncs(map[string]interface{}{
+		"button":  button,
+		"failing": failing,
+		"items":   items,
+	})).Funcs(template.FuncMap{"class": Class}).Parse(`{{define "button"}}<form>{{button "Go" (class "primary")}}</form>{{end}}{{define "failing"}}{{failing}}{{end}}` +
+		`{{define "items"}}<ul>{{items "a" "b"}}</ul>{{end}}`))
+
+	t.Run("calls components from templates", func(t *testing.T) {
+		var b strings.Builder
+		if err := tmpl.ExecuteTemplate(&b, "button", nil); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != `<form><button class="primary">Go</button></form>` {
+			t.Fatal(b.String())
+		}
+	})
+
+	t.Run("renders groups from components", func(t *testing.T) {
+		var b strings.Builder
+		if err := tmpl.ExecuteTemplate(&b, "items", nil); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != `<ul><li>a</li><li>b</li></ul>` {
+			t.Fatal(b.String())
+		}
+	})
+
+	t.Run("returns errors from functions returning an error", func(t *testing.T) {
+		err := tmpl.ExecuteTemplate(&strings.Builder{}, "failing", nil)
+		if err == nil || !strings.Contains(err.Error(), "don't want to") {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("panics for functions not returning a node", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.FailNow()
+			}
+		}()
+		TemplateFunc(func() string { return "" })
+	})
+}
+
+func ExampleTemplate() {
+	tmpl := template.Must(template.New("greeting").Parse(`<p>Hello, {{.}}!</p>`))
+	e := Div(Template(tmpl, "greeting", "World"))
+	_ = e.Render(os.Stdout)
+	// Output: <div><p>Hello, World!</p></div>
+}
+
+func ExampleTemplateFunc() {
+	tmpl := template.Must(template.New("").Funcs(template.FuncMap{"link": TemplateFunc(func(href, text string) Node {
+		return A(Href(href), Text(text))
+	})}).Parse(`<nav>{{link "/" "Home"}}</nav>`))
+	_ = tmpl.Execute(os.Stdout, nil)
+	// Output: <nav><a href="/">Home</a></nav>
+}
@@ -0,0 +1,106 @@
+package html
+
+// parentNode is implemented by Nodes with child Nodes, so trees of them can be walked and transformed.
+type parentNode interface {
+	// childNodes returns the child Nodes as given, which may include nil Nodes and Groups.
+	childNodes() []Node
+	// withChildNodes returns a copy of the Node with the given child Nodes.
+	withChildNodes(children []Node) Node
+}
+
+func (e *Element) childNodes() []Node {
+	return e.Children
+}
+
+func (e *Element) withChildNodes(children []Node) Node {
+	return &Element{Name: e.Name, Children: children}
+}
+
+func (d DoctypeNode) childNodes() []Node {
+	return []Node{d.Sibling}
+}
+
+func (d DoctypeNode) withChildNodes(children []Node) Node {
+	if len(children) == 0 {
+		return DoctypeNode{}
+	}
+	return DoctypeNode{Sibling: children[0]}
+}
+
+func (f *formatter) childNodes() []Node {
+	return []Node{f.n}
+}
+
+func (f *formatter) withChildNodes(children []Node) Node {
+	if len(children) == 0 {
+		return &formatter{format: f.format}
+	}
+	return &formatter{n: children[0], format: f.format}
+}
+
+func (p *parallel) childNodes() []Node {
+	return p.children
+}
+
+func (p *parallel) withChildNodes(children []Node) Node {
+	return &parallel{limit: p.limit, children: children}
+}
+
+// Walk the tree of Nodes starting at n in depth-first order, calling fn for each Node.
+// If fn returns false, the child Nodes of that Node are skipped.
+//
+// Walk goes into Elements (both attribute and element children), Doctype, Indent, Minify, and Parallel.
+// Groups are walked through without calling fn for them, and nil Nodes are skipped.
+// Nodes that are only known at render time, such as the ones from FromContext and Deferred, are not walked into.
+func Walk(n Node, fn func(Node) bool) {
+	if n == nil {
+		return
+	}
+	if g, ok := n.(GroupNode); ok {
+		for _, c := range g {
+			Walk(c, fn)
+		}
+		return
+	}
+
+	if !fn(n) {
+		return
+	}
+	if p, ok := n.(parentNode); ok {
+		for _, c := range p.childNodes() {
+			Walk(c, fn)
+		}
+	}
+}
+
+// Transform the tree of Nodes starting at n, by calling fn for each Node and using the returned Node in its place.
+// Returning nil removes the Node. The tree is walked like in Walk, and fn is called with the child Nodes
+// of a Node before the Node itself, which then already has the transformed children.
+//
+// Transform returns a new tree and never changes the given one, so shared Nodes like components used in
+// several places stay the same. Nodes with children are copied before fn is called with them,
+// so fn may change the fields of an *Element it gets, but not the Nodes it holds, such as its *Attributes.
+// Use the *Element methods SetAttribute and RemoveAttribute to change attributes.
+func Transform(n Node, fn func(Node) Node) Node {
+	if n == nil {
+		return nil
+	}
+	if g, ok := n.(GroupNode); ok {
+		return GroupNode(transformAll(g, fn))
+	}
+
+	if p, ok := n.(parentNode); ok {
+		n = p.withChildNodes(transformAll(p.childNodes(), fn))
+	}
+	return fn(n)
+}
+
+func transformAll(nodes []Node, fn func(Node) Node) []Node {
+	result := make([]Node, 0, len(nodes))
+	for _, n := range nodes {
+		if t := Transform(n, fn); t != nil {
+			result = append(result, t)
+		}
+	}
+	return result
+}
@@ -0,0 +1,198 @@
+package html
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestWalk(t *testing.T) {
+	t.Run("visits nodes depth-first through groups, skipping nil nodes", func(t *testing.T) {
+		n := Doctype(El("html", Attr("lang", "en"), nil, Group([]Node{El("body", Text("hat"))}), If(false, El("span"))))
+
+		var visited []string
+		Walk(n, func(n Node) bool {
+			switch n := n.(type) {
+			case DoctypeNode:
+				visited = append(visited, "doctype")
+			case *Element:
+				visited = append(visited, n.Name)
+			case *Attribute:
+				visited = append(visited, n.Name+"="+n.Value)
+			case TextNode:
+				visited = append(visited, string(n))
+			default:
+				visited = append(visited, fmt.Sprintf("%T", n))
+			}
+			return true
+		})
+
+		if strings.Join(visited, ",") != "doctype,html,lang=en,body,hat" {
+			t.Fatal("visited", visited)
+		}
+	})
+
+	t.Run("skips children if the function returns false", func(t *testing.T) {
+		n := El("div", El("svg", El("path")), El("span"))
+
+		var visited []string
+		Walk(n, func(n Node) bool {
+			e := n.(*Element)
+			visited = append(visited, e.Name)
+			return e.Name != "svg"
+		})
+
+		if strings.Join(visited, ",") != "div,svg,span" {
+			t.Fatal("visited", visited)
+		}
+	})
+
+	t.Run("walks into formatters and parallel nodes", func(t *testing.T) {
+		n := Indent(Minify(Parallel(El("span"))), "  ")
+
+		var visited []string
+		Walk(n, func(n Node) bool {
+			if e, ok := n.(*Element); ok {
+				visited = append(visited, e.Name)
+			}
+			return true
+		})
+
+		if strings.Join(visited, ",") != "span" {
+			t.Fatal("visited", visited)
+		}
+	})
+
+	t.Run("does nothing for a nil node", func(t *testing.T) {
+		Walk(nil, func(Node) bool {
+			t.FailNow()
+			return true
+		})
+	})
+}
+
+func TestTransform(t *testing.T) {
+	t.Run("adds attributes to elements without changing the original tree", func(t *testing.T) {
+		link := A(Href("https://example.com"), Text("Example"))
+		n := Div(link, A(Href("/hats"), Text("Hats")), link)
+
+		transformed := Transform(n, func(n Node) Node {
+			if e, ok := n.(*Element); ok && e.Name == "a" {
+				if href, _ := e.GetAttribute("href"); strings.HasPrefix(href, "https://") {
+					e.SetAttribute("rel", "noopener")
+				}
+			}
+			return n
+		})
+
+		Equal(t, `<div><a href="https://example.com" rel="noopener">Example</a><a href="/hats">Hats</a>`+
+			`<a href="https://example.com" rel="noopener">Example</a></div>`, transformed)
+		Equal(t, `<div><a href="https://example.com">Example</a><a href="/hats">Hats</a>`+
+			`<a href="https://example.com">Example</a></div>`, n)
+	})
+
+	t.Run("changes attributes given as classes and in groups", func(t *testing.T) {
+		n := HTML5(HTML5Props{
+			Title: "Hat",
+			Body: []Node{
+				Table(Classes{"hats": true}),
+				Table(Group([]Node{Class("caps")})),
+				Script(Src("/app.js")),
+			},
+		})
+
+		transformed := Transform(n, func(n Node) Node {
+			e, ok := n.(*Element)
+			if !ok {
+				return n
+			}
+			switch e.Name {
+			case "table":
+				class, _ := e.GetAttribute("class")
+				e.SetAttribute("class", strings.TrimSpace(class+" striped"))
+			case "script":
+				e.SetAttribute("nonce", "abc")
+			}
+			return e
+		})
+
+		Equal(t, `<!doctype html><html><head><meta charset="utf-8"><meta name="viewport" content="width=device-width, initial-scale=1">`+
+			`<title>Hat</title></head><body><table class="hats striped"></table><table class="caps striped"></table>`+
+			`<script src="/app.js" nonce="abc"></script></body></html>`, transformed)
+	})
+
+	t.Run("removes nodes for which the function returns nil", func(t *testing.T) {
+		n := Ul(Li(Text("hat")), Li(Class("hidden"), Text("cap")), Li(Text("party")))
+
+		transformed := Transform(n, func(n Node) Node {
+			if e, ok := n.(*Element); ok {
+				if class, _ := e.GetAttribute("class"); class == "hidden" {
+					return nil
+				}
+			}
+			return n
+		})
+
+		Equal(t, `<ul><li>hat</li><li>party</li></ul>`, transformed)
+	})
+
+	t.Run("replaces nodes", func(t *testing.T) {
+		n := Doctype(P(Text("hat"), Strong(Text("party"))))
+
+		transformed := Transform(n, func(n Node) Node {
+			if text, ok := n.(TextNode); ok {
+				return Text(strings.ToUpper(string(text)))
+			}
+			return n
+		})
+
+		Equal(t, `<!doctype html><p>HAT<strong>PARTY</strong></p>`, transformed)
+	})
+
+	t.Run("calls the function with children first", func(t *testing.T) {
+		var visited []string
+		Transform(El("div", El("span"), El("p")), func(n Node) Node {
+			visited = append(visited, n.(*Element).Name)
+			return n
+		})
+
+		if strings.Join(visited, ",") != "span,p,div" {
+			t.Fatal("visited", visited)
+		}
+	})
+
+	t.Run("transforms formatters and parallel nodes", func(t *testing.T) {
+		n := Minify(Parallel(P(Text("hat")), Text("cap")))
+
+		transformed := Transform(n, func(n Node) Node {
+			if _, ok := n.(TextNode); ok {
+				return nil
+			}
+			return n
+		})
+
+		Equal(t, `<p></p>`, transformed)
+	})
+
+	t.Run("returns nil for a nil node", func(t *testing.T) {
+		if Transform(nil, func(n Node) Node { return n }) != nil {
+			t.FailNow()
+		}
+	})
+}
+
+func ExampleTransform() {
+	page := Div(A(Href("https://example.com"), Text("Example")), A(Href("/"), Text("Home")))
+
+	e := Transform(page, func(n Node) Node {
+		if e, ok := n.(*Element); ok && e.Name == "a" {
+			if href, _ := e.GetAttribute("href"); strings.HasPrefix(href, "https://") {
+				e.SetAttribute("rel", "noopener")
+			}
+		}
+		return n
+	})
+	_ = e.Render(os.Stdout)
+	// Output: <div><a href="https://example.com" rel="noopener">Example</a><a href="/">Home</a></div>
+}